@@ -1,6 +1,7 @@
 package contentful
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -30,9 +31,9 @@ func TestAccessTokensServiceList(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	collection, err := cma.AccessTokens.List().Next()
+	collection, err := cma.AccessTokens.List().Next(context.Background())
 	assertions.Nil(err)
 	keys := collection.ToAccessToken()
 	assertions.Equal(2, len(keys))
@@ -59,9 +60,9 @@ func TestAccessTokensServiceGet(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	key, err := cma.AccessTokens.Get("hioj6879UYGIfyt654tyfFHG")
+	key, err := cma.AccessTokens.Get(context.Background(), "hioj6879UYGIfyt654tyfFHG")
 	assertions.Nil(err)
 	assertions.Equal("hioj6879UYGIfyt654tyfFHG", key.Sys.ID)
 }
@@ -86,10 +87,11 @@ func TestAccessTokensServiceGet_2(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	_, err = cma.AccessTokens.Get("hioj6879UYGIfyt654tyfFHG")
-	assertions.Nil(err)
+	accessToken, err := cma.AccessTokens.Get(context.Background(), "hioj6879UYGIfyt654tyfFHG")
+	assertions.NotNil(err)
+	assertions.Nil(accessToken)
 }
 
 func TestEntriesServiceCreate(t *testing.T) {
@@ -122,7 +124,7 @@ func TestEntriesServiceCreate(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	accessToken := &AccessToken{
 		Name:      "Example Access Token",
@@ -132,7 +134,7 @@ func TestEntriesServiceCreate(t *testing.T) {
 		},
 	}
 
-	err = cma.AccessTokens.Create(accessToken)
+	err = cma.AccessTokens.Create(context.Background(), accessToken)
 	assertions.Nil(err)
 }
 
@@ -161,14 +163,14 @@ func TestAccessTokensService_Revoke(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	accessToken, err := accessTokenFromTestFile("access_token_updated.json")
 	assertions.Nil(err)
 
 	accessToken.RevokedAt = "2020-03-25T14:40:24Z"
 
-	err = cma.AccessTokens.Revoke(accessToken)
+	err = cma.AccessTokens.Revoke(context.Background(), accessToken)
 	assertions.Nil(err)
 	assertions.Equal(2, accessToken.Sys.Version)
 	assertions.Equal(2, accessToken.GetVersion())
@@ -1,6 +1,7 @@
 package contentful
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -30,9 +31,9 @@ func TestAPIKeyService_List(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	res, err := cma.APIKeys.List(spaceID).Next()
+	res, err := cma.APIKeys.List(spaceID).Next(context.Background())
 	assertions.Nil(err)
 	keys := res.ToAPIKey()
 	assertions.Equal(1, len(keys))
@@ -59,9 +60,9 @@ func TestAPIKeyService_Get(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	key, err := cma.APIKeys.Get(spaceID, "exampleapikey")
+	key, err := cma.APIKeys.Get(context.Background(), spaceID, "exampleapikey")
 	assertions.Nil(err)
 	assertions.Equal("exampleapikey", key.Sys.ID)
 }
@@ -86,9 +87,9 @@ func TestAPIKeyService_Get_2(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	_, err = cma.APIKeys.Get(spaceID, "exampleapikey")
+	_, err = cma.APIKeys.Get(context.Background(), spaceID, "exampleapikey")
 	assertions.NotNil(err)
 }
 
@@ -115,7 +116,7 @@ func TestAPIKeyService_Upsert_Create(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	key := &APIKey{
 		Name:        "Example API Key",
@@ -138,7 +139,7 @@ func TestAPIKeyService_Upsert_Create(t *testing.T) {
 		},
 	}
 
-	err := cma.APIKeys.Upsert(spaceID, key)
+	err := cma.APIKeys.Upsert(context.Background(), spaceID, key)
 	assertions.Nil(err)
 	assertions.Equal("exampleapikey", key.Sys.ID)
 	assertions.Equal("Example API Key", key.Name)
@@ -168,14 +169,14 @@ func TestAPIKeyService_Upsert_Update(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	key, err := apiKeyFromTestData("api_key_1.json")
 	assertions.Nil(err)
 
 	key.Name = "This name is updated"
 
-	err = cma.APIKeys.Upsert(spaceID, key)
+	err = cma.APIKeys.Upsert(context.Background(), spaceID, key)
 	assertions.Nil(err)
 	assertions.Equal("This name is updated", key.Name)
 }
@@ -198,13 +199,13 @@ func TestAPIKeyService_Delete(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	// test locale
 	key, err := apiKeyFromTestData("api_key_1.json")
 	assertions.Nil(err)
 
 	// delete locale
-	err = cma.APIKeys.Delete(spaceID, key)
+	err = cma.APIKeys.Delete(context.Background(), spaceID, key)
 	assertions.Nil(err)
 }
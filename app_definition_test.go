@@ -1,6 +1,7 @@
 package contentful
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/stretchr/testify/assert"
@@ -29,9 +30,9 @@ func TestAppDefinitionsService_List(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	collection, err := cma.AppDefinitions.List("organization_id").Next()
+	collection, err := cma.AppDefinitions.List("organization_id").Next(context.Background())
 	assertions.Nil(err)
 
 	definitions := collection.ToAppDefinition()
@@ -60,9 +61,9 @@ func TestAppDefinitionsService_Get(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	definition, err := cma.AppDefinitions.Get("organization_id", "app_definition_id")
+	definition, err := cma.AppDefinitions.Get(context.Background(), "organization_id", "app_definition_id")
 	assertions.Nil(err)
 	assertions.Equal("app_definition_id", definition.Sys.ID)
 	assertions.Equal("Hello world!", definition.Name)
@@ -88,10 +89,11 @@ func TestAppDefinitionsService_Get_2(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	_, err = cma.AppDefinitions.Get("organization_id", "app_definition_id")
-	assertions.Nil(err)
+	definition, err := cma.AppDefinitions.Get(context.Background(), "organization_id", "app_definition_id")
+	assertions.NotNil(err)
+	assertions.Nil(definition)
 }
 
 func TestAppDefinitionsService_Upsert_Create(t *testing.T) {
@@ -118,7 +120,7 @@ func TestAppDefinitionsService_Upsert_Create(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	definition := &AppDefinition{
 		Name: "Hello world!",
@@ -130,7 +132,7 @@ func TestAppDefinitionsService_Upsert_Create(t *testing.T) {
 		},
 	}
 
-	err := cma.AppDefinitions.Upsert("organization_id", definition)
+	err := cma.AppDefinitions.Upsert(context.Background(), "organization_id", definition)
 	assertions.Nil(err)
 	assertions.Equal("app_definition_id", definition.Sys.ID)
 	assertions.Equal("Hello world!", definition.Name)
@@ -161,7 +163,7 @@ func TestAppDefinitionsService_Upsert_Update(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	definition, err := appDefinitionFromTestFile("app_definition_1.json")
 	assertions.Nil(err)
@@ -169,7 +171,7 @@ func TestAppDefinitionsService_Upsert_Update(t *testing.T) {
 	definition.Name = "Hello Pluto"
 	definition.SRC = "https://example.com/hellopluto.html"
 
-	err = cma.AppDefinitions.Upsert("organization_id", definition)
+	err = cma.AppDefinitions.Upsert(context.Background(), "organization_id", definition)
 	assertions.Nil(err)
 	assertions.Equal("Hello Pluto", definition.Name)
 	assertions.Equal("https://example.com/hellopluto.html", definition.SRC)
@@ -193,11 +195,11 @@ func TestAppDefinitionsService_Delete(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	definition, err := appDefinitionFromTestFile("app_definition_1.json")
 	assertions.Nil(err)
 
-	err = cma.AppDefinitions.Delete("organization_id", definition.Sys.ID)
+	err = cma.AppDefinitions.Delete(context.Background(), "organization_id", definition.Sys.ID)
 	assertions.Nil(err)
 }
@@ -2,9 +2,9 @@ package contentful
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
-	"net/http"
 	"net/url"
 	"strconv"
 )
@@ -30,41 +30,32 @@ func (appInstallation *AppInstallation) GetVersion() int {
 
 // List returns an app installations collection
 func (service *AppInstallationsService) List(spaceID string) *Collection {
-	path := fmt.Sprintf("/spaces/%s/environments/%s/app_installations", spaceID, service.c.Environment)
-
-	req, err := service.c.newRequest(http.MethodGet, path, nil, nil)
-	if err != nil {
-		return &Collection{}
-	}
-
-	col := NewCollection(&CollectionOptions{})
-	col.c = service.c
-	col.req = req
-
-	return col
+	return service.c.environmentCollection(func(environment string) string {
+		return fmt.Sprintf("/spaces/%s/environments/%s/app_installations", spaceID, environment)
+	})
 }
 
 // Get returns a single app installation
-func (service *AppInstallationsService) Get(spaceID, appInstallationID string) (*AppInstallation, error) {
-	path := fmt.Sprintf("/spaces/%s/environments/%s/app_installations/%s", spaceID, service.c.Environment, appInstallationID)
+func (service *AppInstallationsService) Get(ctx context.Context, spaceID, appInstallationID string) (*AppInstallation, error) {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/app_installations/%s", spaceID, service.c.environment(ctx), appInstallationID)
 	query := url.Values{}
 	method := "GET"
 
-	req, err := service.c.newRequest(method, path, query, nil)
+	req, err := service.c.newRequest(ctx, method, path, query, nil)
 	if err != nil {
 		return &AppInstallation{}, err
 	}
 
 	var installation AppInstallation
-	if ok := service.c.do(req, &installation); ok != nil {
+	if err := service.c.do(req, &installation); err != nil {
 		return nil, err
 	}
 
-	return &installation, err
+	return &installation, nil
 }
 
 // Upsert updates or creates a new app installation
-func (service *AppInstallationsService) Upsert(spaceID, appInstallationID string, installation *AppInstallation) error {
+func (service *AppInstallationsService) Upsert(ctx context.Context, spaceID, appInstallationID string, installation *AppInstallation) error {
 	bytesArray, err := json.Marshal(installation)
 	if err != nil {
 		return err
@@ -74,14 +65,14 @@ func (service *AppInstallationsService) Upsert(spaceID, appInstallationID string
 	var method string
 
 	if appInstallationID != "" {
-		path = fmt.Sprintf("/spaces/%s/environments/%s/app_installations/%s", spaceID, service.c.Environment, appInstallationID)
+		path = fmt.Sprintf("/spaces/%s/environments/%s/app_installations/%s", spaceID, service.c.environment(ctx), appInstallationID)
 		method = "PUT"
 	} else {
-		path = fmt.Sprintf("/spaces/%s/environments/%s/app_installations", spaceID, service.c.Environment)
+		path = fmt.Sprintf("/spaces/%s/environments/%s/app_installations", spaceID, service.c.environment(ctx))
 		method = "POST"
 	}
 
-	req, err := service.c.newRequest(method, path, nil, bytes.NewReader(bytesArray))
+	req, err := service.c.newRequest(ctx, method, path, nil, bytes.NewReader(bytesArray))
 	if err != nil {
 		return err
 	}
@@ -92,11 +83,11 @@ func (service *AppInstallationsService) Upsert(spaceID, appInstallationID string
 }
 
 // Delete the app installation
-func (service *AppInstallationsService) Delete(spaceID, appInstallationID string) error {
-	path := fmt.Sprintf("/spaces/%s/environments/%s/app_installations/%s", spaceID, service.c.Environment, appInstallationID)
+func (service *AppInstallationsService) Delete(ctx context.Context, spaceID, appInstallationID string) error {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/app_installations/%s", spaceID, service.c.environment(ctx), appInstallationID)
 	method := "DELETE"
 
-	req, err := service.c.newRequest(method, path, nil, nil)
+	req, err := service.c.newRequest(ctx, method, path, nil, nil)
 	if err != nil {
 		return err
 	}
@@ -1,6 +1,7 @@
 package contentful
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/stretchr/testify/assert"
@@ -29,9 +30,9 @@ func TestAppInstallationsService_List(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	collection, err := cma.AppInstallations.List(spaceID).Next()
+	collection, err := cma.AppInstallations.List(spaceID).Next(context.Background())
 	assertions.Nil(err)
 
 	installation := collection.ToAppInstallation()
@@ -59,9 +60,9 @@ func TestAppInstallationsService_Get(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	installation, err := cma.AppInstallations.Get(spaceID, "app_definition_id")
+	installation, err := cma.AppInstallations.Get(context.Background(), spaceID, "app_definition_id")
 	assertions.Nil(err)
 	assertions.Equal("world", installation.Parameters["hello"])
 }
@@ -86,10 +87,11 @@ func TestAppInstallationsService_Get_2(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	_, err = cma.AppInstallations.Get(spaceID, "app_definition_id")
-	assertions.Nil(err)
+	installation, err := cma.AppInstallations.Get(context.Background(), spaceID, "app_definition_id")
+	assertions.NotNil(err)
+	assertions.Nil(installation)
 }
 
 func TestAppInstallationsService_Upsert_Create(t *testing.T) {
@@ -116,7 +118,7 @@ func TestAppInstallationsService_Upsert_Create(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	installation := &AppInstallation{
 		Parameters: map[string]string{
@@ -124,7 +126,7 @@ func TestAppInstallationsService_Upsert_Create(t *testing.T) {
 		},
 	}
 
-	err := cma.AppInstallations.Upsert(spaceID, "", installation)
+	err := cma.AppInstallations.Upsert(context.Background(), spaceID, "", installation)
 	assertions.Nil(err)
 	assertions.Equal("world", installation.Parameters["hello"])
 }
@@ -154,14 +156,14 @@ func TestAppInstallationsService_Upsert_Update(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	installation, err := appInstallationFromTestFile("app_installation_1.json")
 	assertions.Nil(err)
 
 	installation.Parameters["lorum"] = "ipsum"
 
-	err = cma.AppInstallations.Upsert(spaceID, "app_definition_id", installation)
+	err = cma.AppInstallations.Upsert(context.Background(), spaceID, "app_definition_id", installation)
 	assertions.Nil(err)
 	assertions.Equal("ipsum", installation.Parameters["lorum"])
 }
@@ -184,8 +186,8 @@ func TestAppInstallationsService_Delete(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	err = cma.AppInstallations.Delete(spaceID, "app_definition_id")
+	err = cma.AppInstallations.Delete(context.Background(), spaceID, "app_definition_id")
 	assertions.Nil(err)
 }
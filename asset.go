@@ -2,6 +2,7 @@ package contentful
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"strconv"
@@ -52,6 +53,69 @@ type ImageFields struct {
 	Height int `json:"height,omitempty"`
 }
 
+// UnmarshalJSON for custom json unmarshaling. Assets of the delivery api
+// requested for a single locale have flat fields, which are stored under the
+// locale of the asset.
+func (asset *Asset) UnmarshalJSON(data []byte) error {
+	type alias Asset
+
+	var payload struct {
+		alias
+		Fields json.RawMessage `json:"fields,omitempty"`
+	}
+
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return err
+	}
+
+	*asset = Asset(payload.alias)
+
+	if len(payload.Fields) == 0 || string(payload.Fields) == "null" {
+		return nil
+	}
+
+	if asset.Sys == nil || asset.Sys.Locale == "" {
+		var fields AssetFields
+		if err := json.Unmarshal(payload.Fields, &fields); err != nil {
+			return err
+		}
+
+		asset.Fields = &fields
+
+		return nil
+	}
+
+	var fields struct {
+		Title       string `json:"title,omitempty"`
+		Description string `json:"description,omitempty"`
+		File        *File  `json:"file,omitempty"`
+	}
+
+	if err := json.Unmarshal(payload.Fields, &fields); err != nil {
+		return err
+	}
+
+	locale := asset.Sys.Locale
+	if asset.Locale == "" {
+		asset.Locale = locale
+	}
+
+	asset.Fields = &AssetFields{}
+	if fields.Title != "" {
+		asset.Fields.Title = map[string]string{locale: fields.Title}
+	}
+
+	if fields.Description != "" {
+		asset.Fields.Description = map[string]string{locale: fields.Description}
+	}
+
+	if fields.File != nil {
+		asset.Fields.File = map[string]*File{locale: fields.File}
+	}
+
+	return nil
+}
+
 // GetVersion returns entity version
 func (asset *Asset) GetVersion() int {
 	version := 1
@@ -64,44 +128,24 @@ func (asset *Asset) GetVersion() int {
 
 // List returns asset collection
 func (service *AssetsService) List(spaceID string) *Collection {
-	path := fmt.Sprintf("/spaces/%s/assets", spaceID)
-	method := "GET"
-
-	req, err := service.c.newRequest(method, path, nil, nil)
-	if err != nil {
-		return &Collection{}
-	}
-
-	col := NewCollection(&CollectionOptions{})
-	col.c = service.c
-	col.req = req
-
-	return col
+	return service.c.environmentCollection(func(environment string) string {
+		return fmt.Sprintf("/spaces/%s/environments/%s/assets", spaceID, environment)
+	})
 }
 
 // ListPublished return a content type collection, with only activated content types
 func (service *AssetsService) ListPublished(spaceID string) *Collection {
-	path := fmt.Sprintf("/spaces/%s/public/assets", spaceID)
-	method := "GET"
-
-	req, err := service.c.newRequest(method, path, nil, nil)
-	if err != nil {
-		return nil
-	}
-
-	col := NewCollection(&CollectionOptions{})
-	col.c = service.c
-	col.req = req
-
-	return col
+	return service.c.environmentCollection(func(environment string) string {
+		return fmt.Sprintf("/spaces/%s/environments/%s/public/assets", spaceID, environment)
+	})
 }
 
 // Get returns a single asset entity
-func (service *AssetsService) Get(spaceID, assetID string) (*Asset, error) {
-	path := fmt.Sprintf("/spaces/%s/assets/%s", spaceID, assetID)
+func (service *AssetsService) Get(ctx context.Context, spaceID, assetID string) (*Asset, error) {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/assets/%s", spaceID, service.c.environment(ctx), assetID)
 	method := "GET"
 
-	req, err := service.c.newRequest(method, path, nil, nil)
+	req, err := service.c.newRequest(ctx, method, path, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -115,7 +159,7 @@ func (service *AssetsService) Get(spaceID, assetID string) (*Asset, error) {
 }
 
 // Upsert updates or creates a new asset entity
-func (service *AssetsService) Upsert(spaceID string, asset *Asset) error {
+func (service *AssetsService) Upsert(ctx context.Context, spaceID string, asset *Asset) error {
 	bytesArray, err := json.Marshal(asset)
 	if err != nil {
 		return err
@@ -125,14 +169,14 @@ func (service *AssetsService) Upsert(spaceID string, asset *Asset) error {
 	var method string
 
 	if asset.Sys != nil && asset.Sys.ID != "" {
-		path = fmt.Sprintf("/spaces/%s/assets/%s", spaceID, asset.Sys.ID)
+		path = fmt.Sprintf("/spaces/%s/environments/%s/assets/%s", spaceID, service.c.environment(ctx), asset.Sys.ID)
 		method = "PUT"
 	} else {
-		path = fmt.Sprintf("/spaces/%s/assets", spaceID)
+		path = fmt.Sprintf("/spaces/%s/environments/%s/assets", spaceID, service.c.environment(ctx))
 		method = "POST"
 	}
 
-	req, err := service.c.newRequest(method, path, nil, bytes.NewReader(bytesArray))
+	req, err := service.c.newRequest(ctx, method, path, nil, bytes.NewReader(bytesArray))
 	if err != nil {
 		return err
 	}
@@ -143,11 +187,11 @@ func (service *AssetsService) Upsert(spaceID string, asset *Asset) error {
 }
 
 // Delete sends delete request
-func (service *AssetsService) Delete(spaceID string, asset *Asset) error {
-	path := fmt.Sprintf("/spaces/%s/assets/%s", spaceID, asset.Sys.ID)
+func (service *AssetsService) Delete(ctx context.Context, spaceID string, asset *Asset) error {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/assets/%s", spaceID, service.c.environment(ctx), asset.Sys.ID)
 	method := "DELETE"
 
-	req, err := service.c.newRequest(method, path, nil, nil)
+	req, err := service.c.newRequest(ctx, method, path, nil, nil)
 	if err != nil {
 		return err
 	}
@@ -159,11 +203,11 @@ func (service *AssetsService) Delete(spaceID string, asset *Asset) error {
 }
 
 // Process the asset
-func (service *AssetsService) Process(spaceID string, asset *Asset) error {
-	path := fmt.Sprintf("/spaces/%s/assets/%s/files/%s/process", spaceID, asset.Sys.ID, asset.Locale)
+func (service *AssetsService) Process(ctx context.Context, spaceID string, asset *Asset) error {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/assets/%s/files/%s/process", spaceID, service.c.environment(ctx), asset.Sys.ID, asset.Locale)
 	method := "PUT"
 
-	req, err := service.c.newRequest(method, path, nil, nil)
+	req, err := service.c.newRequest(ctx, method, path, nil, nil)
 	if err != nil {
 		return err
 	}
@@ -175,11 +219,11 @@ func (service *AssetsService) Process(spaceID string, asset *Asset) error {
 }
 
 // Publish published the asset
-func (service *AssetsService) Publish(spaceID string, asset *Asset) error {
-	path := fmt.Sprintf("/spaces/%s/assets/%s/published", spaceID, asset.Sys.ID)
+func (service *AssetsService) Publish(ctx context.Context, spaceID string, asset *Asset) error {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/assets/%s/published", spaceID, service.c.environment(ctx), asset.Sys.ID)
 	method := "PUT"
 
-	req, err := service.c.newRequest(method, path, nil, nil)
+	req, err := service.c.newRequest(ctx, method, path, nil, nil)
 	if err != nil {
 		return err
 	}
@@ -191,11 +235,11 @@ func (service *AssetsService) Publish(spaceID string, asset *Asset) error {
 }
 
 // Unpublish the asset
-func (service *AssetsService) Unpublish(spaceID string, asset *Asset) error {
-	path := fmt.Sprintf("/spaces/%s/assets/%s/published", spaceID, asset.Sys.ID)
+func (service *AssetsService) Unpublish(ctx context.Context, spaceID string, asset *Asset) error {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/assets/%s/published", spaceID, service.c.environment(ctx), asset.Sys.ID)
 	method := "DELETE"
 
-	req, err := service.c.newRequest(method, path, nil, nil)
+	req, err := service.c.newRequest(ctx, method, path, nil, nil)
 	if err != nil {
 		return err
 	}
@@ -207,11 +251,11 @@ func (service *AssetsService) Unpublish(spaceID string, asset *Asset) error {
 }
 
 // Archive archives the asset
-func (service *AssetsService) Archive(spaceID string, asset *Asset) error {
-	path := fmt.Sprintf("/spaces/%s/assets/%s/archived", spaceID, asset.Sys.ID)
+func (service *AssetsService) Archive(ctx context.Context, spaceID string, asset *Asset) error {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/assets/%s/archived", spaceID, service.c.environment(ctx), asset.Sys.ID)
 	method := "PUT"
 
-	req, err := service.c.newRequest(method, path, nil, nil)
+	req, err := service.c.newRequest(ctx, method, path, nil, nil)
 	if err != nil {
 		return err
 	}
@@ -223,11 +267,11 @@ func (service *AssetsService) Archive(spaceID string, asset *Asset) error {
 }
 
 // Unarchive unarchives the asset
-func (service *AssetsService) Unarchive(spaceID string, asset *Asset) error {
-	path := fmt.Sprintf("/spaces/%s/assets/%s/archived", spaceID, asset.Sys.ID)
+func (service *AssetsService) Unarchive(ctx context.Context, spaceID string, asset *Asset) error {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/assets/%s/archived", spaceID, service.c.environment(ctx), asset.Sys.ID)
 	method := "DELETE"
 
-	req, err := service.c.newRequest(method, path, nil, nil)
+	req, err := service.c.newRequest(ctx, method, path, nil, nil)
 	if err != nil {
 		return err
 	}
@@ -1,6 +1,7 @@
 package contentful
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -16,7 +17,7 @@ func TestAssetsService_List(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "GET")
-		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/assets")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/master/assets")
 
 		checkHeaders(r, assertions)
 
@@ -30,9 +31,9 @@ func TestAssetsService_List(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	collection, err := cma.Assets.List(spaceID).Next()
+	collection, err := cma.Assets.List(spaceID).Next(context.Background())
 	assertions.Nil(err)
 	asset := collection.ToAsset()
 	assertions.Equal(3, len(asset))
@@ -45,7 +46,7 @@ func TestAssetsService_ListPublished(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "GET")
-		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/public/assets")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/master/public/assets")
 
 		checkHeaders(r, assertions)
 
@@ -59,9 +60,9 @@ func TestAssetsService_ListPublished(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	collection, err := cma.Assets.ListPublished(spaceID).Next()
+	collection, err := cma.Assets.ListPublished(spaceID).Next(context.Background())
 	assertions.Nil(err)
 	asset := collection.ToAsset()
 	assertions.Equal(3, len(asset))
@@ -74,7 +75,7 @@ func TestAssetsService_Get(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "GET")
-		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/assets/1x0xpXu4pSGS4OukSyWGUK")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/master/assets/1x0xpXu4pSGS4OukSyWGUK")
 
 		checkHeaders(r, assertions)
 
@@ -88,9 +89,9 @@ func TestAssetsService_Get(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	asset, err := cma.Assets.Get(spaceID, "1x0xpXu4pSGS4OukSyWGUK")
+	asset, err := cma.Assets.Get(context.Background(), spaceID, "1x0xpXu4pSGS4OukSyWGUK")
 	assertions.Nil(err)
 	assertions.Equal("hehehe", asset.Fields.Title["en-US"])
 }
@@ -101,7 +102,7 @@ func TestAssetsService_Get_2(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "GET")
-		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/assets/1x0xpXu4pSGS4OukSyWGUK")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/master/assets/1x0xpXu4pSGS4OukSyWGUK")
 
 		checkHeaders(r, assertions)
 
@@ -115,9 +116,9 @@ func TestAssetsService_Get_2(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	_, err = cma.Assets.Get(spaceID, "1x0xpXu4pSGS4OukSyWGUK")
+	_, err = cma.Assets.Get(context.Background(), spaceID, "1x0xpXu4pSGS4OukSyWGUK")
 	assertions.NotNil(err)
 }
 
@@ -126,7 +127,7 @@ func TestAssetsService_Upsert_Create(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "POST")
-		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/assets")
+		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/environments/master/assets")
 		checkHeaders(r, assertions)
 
 		var payload map[string]interface{}
@@ -146,7 +147,7 @@ func TestAssetsService_Upsert_Create(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	asset := &Asset{
 		Locale: "en-US",
@@ -177,7 +178,7 @@ func TestAssetsService_Upsert_Create(t *testing.T) {
 		},
 	}
 
-	err := cma.Assets.Upsert(spaceID, asset)
+	err := cma.Assets.Upsert(context.Background(), spaceID, asset)
 	assertions.Nil(err)
 	assertions.Equal("hehehe", asset.Fields.Title["en-US"])
 	assertions.Equal("d3b8dad44e5066cfb805e2357469ee64.png", asset.Fields.File["en-US"].FileName)
@@ -189,7 +190,7 @@ func TestAssetsService_Upsert_Update(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "PUT")
-		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/assets/3HNzx9gvJScKku4UmcekYw")
+		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/environments/master/assets/3HNzx9gvJScKku4UmcekYw")
 		checkHeaders(r, assertions)
 
 		var payload map[string]interface{}
@@ -211,7 +212,7 @@ func TestAssetsService_Upsert_Update(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	asset, err := assetFromTestData("asset_1.json")
 	assertions.Nil(err)
@@ -219,7 +220,7 @@ func TestAssetsService_Upsert_Update(t *testing.T) {
 	asset.Fields.Title["en-US"] = "updated"
 	asset.Fields.Description["en-US"] = "also updated"
 
-	err = cma.Assets.Upsert(spaceID, asset)
+	err = cma.Assets.Upsert(context.Background(), spaceID, asset)
 	assertions.Nil(err)
 	assertions.Equal("updated", asset.Fields.Title["en-US"])
 	assertions.Equal("also updated", asset.Fields.Description["en-US"])
@@ -231,7 +232,7 @@ func TestAssetsService_Delete(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "DELETE")
-		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/assets/3HNzx9gvJScKku4UmcekYw")
+		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/environments/master/assets/3HNzx9gvJScKku4UmcekYw")
 		checkHeaders(r, assertions)
 
 		w.WriteHeader(200)
@@ -243,14 +244,14 @@ func TestAssetsService_Delete(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	// test asset
 	asset, err := assetFromTestData("asset_1.json")
 	assertions.Nil(err)
 
 	// delete locale
-	err = cma.Assets.Delete(spaceID, asset)
+	err = cma.Assets.Delete(context.Background(), spaceID, asset)
 	assertions.Nil(err)
 }
 
@@ -260,7 +261,7 @@ func TestAssetsService_Process(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "PUT")
-		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/assets/3HNzx9gvJScKku4UmcekYw/files//process")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/master/assets/3HNzx9gvJScKku4UmcekYw/files//process")
 
 		checkHeaders(r, assertions)
 
@@ -274,13 +275,13 @@ func TestAssetsService_Process(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	// test asset
 	asset, err := assetFromTestData("asset_1.json")
 	assertions.Nil(err)
 
-	err = cma.Assets.Process(spaceID, asset)
+	err = cma.Assets.Process(context.Background(), spaceID, asset)
 	assertions.Nil(err)
 }
 
@@ -290,7 +291,7 @@ func TestAssetsService_Publish(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "PUT")
-		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/assets/3HNzx9gvJScKku4UmcekYw/published")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/master/assets/3HNzx9gvJScKku4UmcekYw/published")
 
 		checkHeaders(r, assertions)
 
@@ -304,13 +305,13 @@ func TestAssetsService_Publish(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	// test asset
 	asset, err := assetFromTestData("asset_1.json")
 	assertions.Nil(err)
 
-	err = cma.Assets.Publish(spaceID, asset)
+	err = cma.Assets.Publish(context.Background(), spaceID, asset)
 	assertions.Nil(err)
 }
 
@@ -320,7 +321,7 @@ func TestContentTypesService_Unpublish(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "DELETE")
-		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/assets/3HNzx9gvJScKku4UmcekYw/published")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/master/assets/3HNzx9gvJScKku4UmcekYw/published")
 
 		checkHeaders(r, assertions)
 
@@ -334,13 +335,13 @@ func TestContentTypesService_Unpublish(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	// test asset
 	asset, err := assetFromTestData("asset_1.json")
 	assertions.Nil(err)
 
-	err = cma.Assets.Unpublish(spaceID, asset)
+	err = cma.Assets.Unpublish(context.Background(), spaceID, asset)
 	assertions.Nil(err)
 }
 
@@ -350,7 +351,7 @@ func TestAssetsService_Archive(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "PUT")
-		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/assets/3HNzx9gvJScKku4UmcekYw/archived")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/master/assets/3HNzx9gvJScKku4UmcekYw/archived")
 
 		checkHeaders(r, assertions)
 
@@ -364,13 +365,13 @@ func TestAssetsService_Archive(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	// test asset
 	asset, err := assetFromTestData("asset_1.json")
 	assertions.Nil(err)
 
-	err = cma.Assets.Archive(spaceID, asset)
+	err = cma.Assets.Archive(context.Background(), spaceID, asset)
 	assertions.Nil(err)
 }
 
@@ -380,7 +381,7 @@ func TestContentTypesService_Unarchive(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "DELETE")
-		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/assets/3HNzx9gvJScKku4UmcekYw/archived")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/master/assets/3HNzx9gvJScKku4UmcekYw/archived")
 
 		checkHeaders(r, assertions)
 
@@ -394,12 +395,12 @@ func TestContentTypesService_Unarchive(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	// test asset
 	asset, err := assetFromTestData("asset_1.json")
 	assertions.Nil(err)
 
-	err = cma.Assets.Unarchive(spaceID, asset)
+	err = cma.Assets.Unarchive(context.Background(), spaceID, asset)
 	assertions.Nil(err)
 }
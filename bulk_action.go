@@ -0,0 +1,269 @@
+package contentful
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// BulkActionsService service
+type BulkActionsService service
+
+// noinspection GoUnusedConst
+const (
+	// BulkActionPublish publishes entities
+	BulkActionPublish = "publish"
+
+	// BulkActionUnpublish unpublishes entities
+	BulkActionUnpublish = "unpublish"
+
+	// BulkActionValidate validates entities before publishing them
+	BulkActionValidate = "validate"
+)
+
+// noinspection GoUnusedConst
+const (
+	// BulkActionStatusCreated bulk action waiting to be processed
+	BulkActionStatusCreated = "created"
+
+	// BulkActionStatusInProgress bulk action being processed
+	BulkActionStatusInProgress = "inProgress"
+
+	// BulkActionStatusSucceeded bulk action processed without errors
+	BulkActionStatusSucceeded = "succeeded"
+
+	// BulkActionStatusFailed bulk action which failed for at least one entity
+	BulkActionStatusFailed = "failed"
+)
+
+// MaxBulkActionItems is the maximum number of entities of a bulk action
+const MaxBulkActionItems = 200
+
+// DefaultBulkActionPollInterval is the interval between two polls of Wait
+const DefaultBulkActionPollInterval = time.Second
+
+// BulkAction model
+type BulkAction struct {
+	Sys     *BulkActionSys     `json:"sys"`
+	Action  string             `json:"action"`
+	Payload *BulkActionPayload `json:"payload,omitempty"`
+	Error   *BulkActionError   `json:"error,omitempty"`
+}
+
+// BulkActionSys model, the status of a bulk action is a string unlike the status of an environment
+type BulkActionSys struct {
+	ID        string `json:"id,omitempty"`
+	Type      string `json:"type,omitempty"`
+	Status    string `json:"status,omitempty"`
+	CreatedAt string `json:"createdAt,omitempty"`
+	UpdatedAt string `json:"updatedAt,omitempty"`
+	CreatedBy *Sys   `json:"createdBy,omitempty"`
+}
+
+// BulkActionPayload model
+type BulkActionPayload struct {
+	Action   string              `json:"action,omitempty"`
+	Entities *BulkActionEntities `json:"entities"`
+}
+
+// BulkActionEntities model, the links to the entities of a bulk action
+type BulkActionEntities struct {
+	Sys   *Sys     `json:"sys,omitempty"`
+	Items []Entity `json:"items"`
+}
+
+// BulkActionError model
+type BulkActionError struct {
+	Sys     *Sys                    `json:"sys,omitempty"`
+	Message string                  `json:"message,omitempty"`
+	Details *BulkActionErrorDetails `json:"details,omitempty"`
+}
+
+// BulkActionErrorDetails model
+type BulkActionErrorDetails struct {
+	Errors []*BulkActionItemError `json:"errors,omitempty"`
+}
+
+// BulkActionItemError model, the error of a single entity of a bulk action
+type BulkActionItemError struct {
+	Entity Entity         `json:"entity"`
+	Error  *ErrorResponse `json:"error"`
+}
+
+func (e *BulkActionError) Error() string {
+	msg := strings.Builder{}
+	msg.WriteString("bulk action failed")
+	if e.Message != "" {
+		msg.WriteString(": " + e.Message)
+	}
+
+	if e.Details == nil {
+		return msg.String()
+	}
+
+	for _, item := range e.Details.Errors {
+		msg.WriteString(fmt.Sprintf("\n%s %s", item.Entity.Sys.LinkType, item.Entity.Sys.ID))
+		if item.Error == nil {
+			continue
+		}
+
+		if item.Error.Sys != nil {
+			msg.WriteString(": " + item.Error.Sys.ID)
+		}
+
+		if item.Error.Details == nil {
+			continue
+		}
+
+		for _, detail := range item.Error.Details.Errors {
+			msg.WriteString(", " + detail.Details)
+		}
+	}
+
+	return msg.String()
+}
+
+// EntityLink returns the link to an entity of a bulk action. The version is
+// the version to publish, it is ignored when unpublishing.
+func EntityLink(linkType, id string, version int) Entity {
+	return Entity{
+		Sys: Sys{
+			Type:     "Link",
+			LinkType: linkType,
+			ID:       id,
+			Version:  version,
+		},
+	}
+}
+
+// EntryLink returns the link to the current version of an entry
+func EntryLink(entry *Entry) Entity {
+	return EntityLink("Entry", entry.Sys.ID, entry.Sys.Version)
+}
+
+// AssetLink returns the link to the current version of an asset
+func AssetLink(asset *Asset) Entity {
+	return EntityLink("Asset", asset.Sys.ID, asset.Sys.Version)
+}
+
+// Done reports whether the bulk action is processed
+func (bulkAction *BulkAction) Done() bool {
+	return bulkAction.Sys != nil &&
+		(bulkAction.Sys.Status == BulkActionStatusSucceeded || bulkAction.Sys.Status == BulkActionStatusFailed)
+}
+
+// Publish creates a bulk action publishing the given versions of entries and assets
+func (service *BulkActionsService) Publish(ctx context.Context, spaceID string, entities []Entity) (*BulkAction, error) {
+	return service.create(ctx, spaceID, BulkActionPublish, &BulkActionPayload{
+		Entities: bulkActionEntities(entities, true),
+	})
+}
+
+// Unpublish creates a bulk action unpublishing entries and assets
+func (service *BulkActionsService) Unpublish(ctx context.Context, spaceID string, entities []Entity) (*BulkAction, error) {
+	return service.create(ctx, spaceID, BulkActionUnpublish, &BulkActionPayload{
+		Entities: bulkActionEntities(entities, false),
+	})
+}
+
+// Validate creates a bulk action validating that entries and assets can be published
+func (service *BulkActionsService) Validate(ctx context.Context, spaceID string, entities []Entity) (*BulkAction, error) {
+	return service.create(ctx, spaceID, BulkActionValidate, &BulkActionPayload{
+		Action:   BulkActionPublish,
+		Entities: bulkActionEntities(entities, false),
+	})
+}
+
+// Get returns a single bulk action
+func (service *BulkActionsService) Get(ctx context.Context, spaceID, bulkActionID string) (*BulkAction, error) {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/bulk_actions/actions/%s", spaceID, service.c.environment(ctx), bulkActionID)
+
+	req, err := service.c.newRequest(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var bulkAction BulkAction
+	if err := service.c.do(req, &bulkAction); err != nil {
+		return nil, err
+	}
+
+	return &bulkAction, nil
+}
+
+// Wait polls the bulk action every interval, DefaultBulkActionPollInterval
+// when zero, until it succeeded or failed. The error of a failed bulk action
+// is returned as a *BulkActionError, holding the errors of each entity.
+func (service *BulkActionsService) Wait(ctx context.Context, spaceID string, bulkAction *BulkAction, interval time.Duration) (*BulkAction, error) {
+	if interval <= 0 {
+		interval = DefaultBulkActionPollInterval
+	}
+
+	for !bulkAction.Done() {
+		if err := sleep(ctx, interval); err != nil {
+			return bulkAction, err
+		}
+
+		current, err := service.Get(ctx, spaceID, bulkAction.Sys.ID)
+		if err != nil {
+			return bulkAction, err
+		}
+
+		bulkAction = current
+	}
+
+	if bulkAction.Sys.Status == BulkActionStatusFailed {
+		if bulkAction.Error == nil {
+			return bulkAction, &BulkActionError{}
+		}
+
+		return bulkAction, bulkAction.Error
+	}
+
+	return bulkAction, nil
+}
+
+func (service *BulkActionsService) create(ctx context.Context, spaceID, action string, payload *BulkActionPayload) (*BulkAction, error) {
+	if len(payload.Entities.Items) > MaxBulkActionItems {
+		return nil, fmt.Errorf("contentful: a bulk action holds at most %d entities, got %d", MaxBulkActionItems, len(payload.Entities.Items))
+	}
+
+	bytesArray, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("/spaces/%s/environments/%s/bulk_actions/%s", spaceID, service.c.environment(ctx), action)
+
+	req, err := service.c.newRequest(ctx, http.MethodPost, path, nil, bytes.NewReader(bytesArray))
+	if err != nil {
+		return nil, err
+	}
+
+	var bulkAction BulkAction
+	if err := service.c.do(req, &bulkAction); err != nil {
+		return nil, err
+	}
+
+	return &bulkAction, nil
+}
+
+// bulkActionEntities wraps links into an array, keeping their versions or not
+func bulkActionEntities(entities []Entity, versioned bool) *BulkActionEntities {
+	items := make([]Entity, len(entities))
+	for i, entity := range entities {
+		items[i] = entity
+		if !versioned {
+			items[i].Sys.Version = 0
+		}
+	}
+
+	return &BulkActionEntities{
+		Sys:   &Sys{Type: "Array"},
+		Items: items,
+	}
+}
@@ -0,0 +1,201 @@
+package contentful
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBulkActionsService_Publish(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "POST")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/master/bulk_actions/publish")
+
+		checkHeaders(r, assertions)
+
+		var payload map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&payload)
+		assertions.Nil(err)
+
+		entities := payload["entities"].(map[string]interface{})
+		assertions.Equal("Array", entities["sys"].(map[string]interface{})["type"])
+
+		items := entities["items"].([]interface{})
+		assertions.Len(items, 2)
+
+		sys := items[0].(map[string]interface{})["sys"].(map[string]interface{})
+		assertions.Equal("Link", sys["type"])
+		assertions.Equal("Entry", sys["linkType"])
+		assertions.Equal("5KsDBWseXY6QegucYAoacS", sys["id"])
+		assertions.Equal(3.0, sys["version"])
+
+		w.WriteHeader(201)
+		_, _ = fmt.Fprintln(w, readTestData("bulk_action.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.baseURL = server.URL
+
+	entry := &Entry{Sys: &Sys{ID: "5KsDBWseXY6QegucYAoacS", Version: 3}}
+	asset := &Asset{Sys: &Sys{ID: "1x0xpXu4pSGS4OukSyWGUK", Version: 7}}
+
+	bulkAction, err := cma.BulkActions.Publish(context.Background(), spaceID, []Entity{EntryLink(entry), AssetLink(asset)})
+	assertions.Nil(err)
+	assertions.Equal("bulk-action-id", bulkAction.Sys.ID)
+	assertions.Equal(BulkActionStatusInProgress, bulkAction.Sys.Status)
+	assertions.Equal(BulkActionPublish, bulkAction.Action)
+	assertions.False(bulkAction.Done())
+	assertions.Len(bulkAction.Payload.Entities.Items, 2)
+}
+
+func TestBulkActionsService_UnpublishAndValidate(t *testing.T) {
+	assertions := assert.New(t)
+
+	var paths []string
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+
+		var payload BulkActionPayload
+		err := json.NewDecoder(r.Body).Decode(&payload)
+		assertions.Nil(err)
+
+		// versions are only sent when publishing
+		assertions.Equal(0, payload.Entities.Items[0].Sys.Version)
+
+		if r.URL.Path == "/spaces/"+spaceID+"/environments/master/bulk_actions/validate" {
+			assertions.Equal(BulkActionPublish, payload.Action)
+		} else {
+			assertions.Equal("", payload.Action)
+		}
+
+		w.WriteHeader(201)
+		_, _ = fmt.Fprintln(w, readTestData("bulk_action.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.baseURL = server.URL
+
+	entities := []Entity{EntityLink("Entry", "5KsDBWseXY6QegucYAoacS", 3)}
+
+	_, err := cma.BulkActions.Unpublish(context.Background(), spaceID, entities)
+	assertions.Nil(err)
+
+	_, err = cma.BulkActions.Validate(context.Background(), spaceID, entities)
+	assertions.Nil(err)
+
+	assertions.Equal([]string{
+		"/spaces/" + spaceID + "/environments/master/bulk_actions/unpublish",
+		"/spaces/" + spaceID + "/environments/master/bulk_actions/validate",
+	}, paths)
+}
+
+func TestBulkActionsService_TooManyItems(t *testing.T) {
+	assertions := assert.New(t)
+
+	cma = NewCMA(CMAToken)
+
+	entities := make([]Entity, MaxBulkActionItems+1)
+	_, err := cma.BulkActions.Publish(context.Background(), spaceID, entities)
+	assertions.NotNil(err)
+}
+
+func TestBulkActionsService_Wait(t *testing.T) {
+	assertions := assert.New(t)
+
+	polls := 0
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "GET")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/master/bulk_actions/actions/bulk-action-id")
+
+		checkHeaders(r, assertions)
+
+		polls++
+		w.WriteHeader(200)
+		if polls < 2 {
+			_, _ = fmt.Fprintln(w, readTestData("bulk_action.json"))
+			return
+		}
+
+		_, _ = fmt.Fprintln(w, readTestData("bulk_action_succeeded.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.baseURL = server.URL
+
+	bulkAction := &BulkAction{Sys: &BulkActionSys{ID: "bulk-action-id", Status: BulkActionStatusCreated}}
+
+	bulkAction, err := cma.BulkActions.Wait(context.Background(), spaceID, bulkAction, 1)
+	assertions.Nil(err)
+	assertions.Equal(2, polls)
+	assertions.Equal(BulkActionStatusSucceeded, bulkAction.Sys.Status)
+	assertions.True(bulkAction.Done())
+}
+
+func TestBulkActionsService_WaitFailed(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("bulk_action_failed.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.baseURL = server.URL
+
+	bulkAction := &BulkAction{Sys: &BulkActionSys{ID: "bulk-action-id", Status: BulkActionStatusInProgress}}
+
+	bulkAction, err := cma.BulkActions.Wait(context.Background(), spaceID, bulkAction, 1)
+	assertions.NotNil(err)
+	assertions.Equal(BulkActionStatusFailed, bulkAction.Sys.Status)
+
+	var bulkActionError *BulkActionError
+	assertions.True(errors.As(err, &bulkActionError))
+	assertions.Len(bulkActionError.Details.Errors, 1)
+
+	itemError := bulkActionError.Details.Errors[0]
+	assertions.Equal("5KsDBWseXY6QegucYAoacS", itemError.Entity.Sys.ID)
+	assertions.Equal("InvalidEntry", itemError.Error.Sys.ID)
+	assertions.Equal("required", itemError.Error.Details.Errors[0].Name)
+	assertions.Contains(err.Error(), "Entry 5KsDBWseXY6QegucYAoacS: InvalidEntry")
+}
+
+func TestBulkActionsService_WaitCanceled(t *testing.T) {
+	assertions := assert.New(t)
+
+	cma = NewCMA(CMAToken)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	bulkAction := &BulkAction{Sys: &BulkActionSys{ID: "bulk-action-id", Status: BulkActionStatusInProgress}}
+	_, err := cma.BulkActions.Wait(ctx, spaceID, bulkAction, 0)
+	assertions.ErrorIs(err, context.Canceled)
+}
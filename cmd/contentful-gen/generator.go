@@ -0,0 +1,337 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"sort"
+	"strings"
+	"unicode"
+
+	contentful "github.com/mborders/contentful-go"
+)
+
+// generator renders Go code for a set of content types
+type generator struct {
+	pkg          string
+	contentTypes []*contentful.ContentType
+	names        map[string]string
+	used         map[string]bool
+	buf          bytes.Buffer
+	imports      map[string]bool
+}
+
+// Generate returns the formatted Go source of the models of the given content types
+func Generate(pkg string, contentTypes []*contentful.ContentType) ([]byte, error) {
+	g := &generator{
+		pkg:     pkg,
+		names:   map[string]string{},
+		used:    map[string]bool{},
+		imports: map[string]bool{},
+	}
+
+	sorted := make([]*contentful.ContentType, 0, len(contentTypes))
+	for _, ct := range contentTypes {
+		if ct.Sys == nil || ct.Sys.ID == "" {
+			return nil, fmt.Errorf("content type %q has no id", ct.Name)
+		}
+
+		sorted = append(sorted, ct)
+	}
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Sys.ID < sorted[j].Sys.ID
+	})
+
+	g.contentTypes = sorted
+
+	// the models are named first, the other declarations of a content type
+	// are suffixed when they collide with a model or with each other
+	for _, ct := range sorted {
+		g.names[ct.Sys.ID] = unique(identifier(ct.Sys.ID), g.used)
+	}
+
+	var body bytes.Buffer
+	for _, ct := range sorted {
+		g.buf.Reset()
+		g.contentType(ct)
+		body.Write(g.buf.Bytes())
+	}
+
+	var out bytes.Buffer
+	out.WriteString("// Code generated by contentful-gen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&out, "package %s\n\n", pkg)
+	out.WriteString("import (\n")
+	if g.imports["time"] {
+		out.WriteString("\t\"time\"\n\n")
+	}
+	out.WriteString("\tcontentful \"github.com/mborders/contentful-go\"\n")
+	out.WriteString(")\n\n")
+	out.Write(body.Bytes())
+
+	source, err := format.Source(out.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %w", err)
+	}
+
+	return source, nil
+}
+
+func (g *generator) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+func (g *generator) contentType(ct *contentful.ContentType) {
+	name := g.names[ct.Sys.ID]
+
+	fields := make([]*contentful.Field, 0, len(ct.Fields))
+	for _, field := range ct.Fields {
+		if field.ID == "" || field.Omitted {
+			continue
+		}
+
+		fields = append(fields, field)
+	}
+
+	used := map[string]bool{"Sys": true}
+	fieldNames := make([]string, len(fields))
+	for i, field := range fields {
+		fieldNames[i] = unique(identifier(field.ID), used)
+	}
+
+	// content type id
+	contentTypeID := unique("ContentType"+name, g.used)
+	g.printf("// %s is the id of the %s content type\n", contentTypeID, comment(ct.Name, ct.Sys.ID))
+	g.printf("const %s = %q\n\n", contentTypeID, ct.Sys.ID)
+
+	// field ids
+	if len(fields) > 0 {
+		g.printf("// %s field ids\n", name)
+		g.printf("const (\n")
+		for i, field := range fields {
+			g.printf("\t%s = %q\n", unique(name+"Field"+fieldNames[i], g.used), field.ID)
+		}
+		g.printf(")\n\n")
+	}
+
+	// enums
+	enums := map[string]string{}
+	for i, field := range fields {
+		values := predefinedValues(field)
+		if len(values) == 0 {
+			continue
+		}
+
+		enum := unique(name+fieldNames[i], g.used)
+		enums[field.ID] = enum
+
+		g.printf("// %s values of the %s field\n", enum, field.ID)
+		g.printf("type %s string\n\n", enum)
+		g.printf("// %s values\n", enum)
+		g.printf("const (\n")
+		for _, value := range values {
+			g.printf("\t%s %s = %q\n", unique(enum+identifier(value), g.used), enum, value)
+		}
+		g.printf(")\n\n")
+	}
+
+	// model
+	if ct.Description != "" {
+		g.printf("// %s %s\n", name, oneLine(ct.Description))
+	} else {
+		g.printf("// %s model of the %s content type\n", name, comment(ct.Name, ct.Sys.ID))
+	}
+	g.printf("type %s struct {\n", name)
+	g.printf("\tSys *contentful.Sys `contentful:\"sys\" json:\"sys,omitempty\"`\n")
+	for i, field := range fields {
+		g.printf("\t%s %s `contentful:%q json:\"%s,omitempty\"`\n", fieldNames[i], g.goType(field, enums[field.ID]), field.ID, field.ID)
+	}
+	g.printf("}\n\n")
+
+	// helpers
+	decode := unique("Decode"+name, g.used)
+	g.printf("// %s decodes an entry of the %s content type for the given locale\n", decode, ct.Sys.ID)
+	g.printf("func %s(entry *contentful.Entry, locale string) (*%s, error) {\n", decode, name)
+	g.printf("\tvar model %s\n", name)
+	g.printf("\tif err := entry.DecodeLocale(&model, locale); err != nil {\n")
+	g.printf("\t\treturn nil, err\n")
+	g.printf("\t}\n\n")
+	g.printf("\treturn &model, nil\n")
+	g.printf("}\n\n")
+
+	link := unique(name+"Link", g.used)
+	g.printf("// %s returns a link to the %s entry with the given id\n", link, ct.Sys.ID)
+	g.printf("func %s(id string) map[string]interface{} {\n", link)
+	g.printf("\treturn map[string]interface{}{\n")
+	g.printf("\t\t\"sys\": map[string]interface{}{\n")
+	g.printf("\t\t\t\"type\":     \"Link\",\n")
+	g.printf("\t\t\t\"linkType\": \"Entry\",\n")
+	g.printf("\t\t\t\"id\":       id,\n")
+	g.printf("\t\t},\n")
+	g.printf("\t}\n")
+	g.printf("}\n\n")
+}
+
+// goType maps a content type field to a Go type
+func (g *generator) goType(field *contentful.Field, enum string) string {
+	if field.Type == contentful.FieldTypeArray {
+		if field.Items == nil {
+			return "[]interface{}"
+		}
+
+		switch field.Items.Type {
+		case contentful.FieldTypeSymbol, contentful.FieldTypeText:
+			if enum != "" {
+				return "[]" + enum
+			}
+
+			return "[]string"
+		case contentful.FieldTypeLink:
+			return "[]" + g.linkType(field.Items.LinkType, field.Items.Validations)
+		default:
+			return "[]interface{}"
+		}
+	}
+
+	switch field.Type {
+	case contentful.FieldTypeSymbol, contentful.FieldTypeText:
+		if enum != "" {
+			return enum
+		}
+
+		return "string"
+	case contentful.FieldTypeInteger:
+		return "int"
+	case contentful.FieldTypeNumber:
+		return "float64"
+	case contentful.FieldTypeBoolean:
+		return "bool"
+	case contentful.FieldTypeDate:
+		g.imports["time"] = true
+		return "time.Time"
+	case contentful.FieldTypeLocation:
+		return "*contentful.Location"
+	case contentful.FieldTypeLink:
+		return g.linkType(field.LinkType, field.Validations)
+	case contentful.FieldTypeRichText:
+		return "*contentful.Document"
+	default:
+		return "map[string]interface{}"
+	}
+}
+
+// linkType returns the model of a link, a generated model when the link is
+// restricted to a single known content type
+func (g *generator) linkType(linkType string, validations []contentful.FieldValidation) string {
+	if linkType == "Asset" {
+		return "*contentful.Asset"
+	}
+
+	for _, validation := range validations {
+		link, ok := validation.(contentful.FieldValidationLink)
+		if !ok || len(link.LinkContentType) != 1 {
+			continue
+		}
+
+		if name, ok := g.names[link.LinkContentType[0]]; ok {
+			return "*" + name
+		}
+	}
+
+	return "*contentful.Entry"
+}
+
+// predefinedValues returns the allowed values of a Symbol or Text field, or of the items of an array of symbols
+func predefinedValues(field *contentful.Field) []string {
+	switch field.Type {
+	case contentful.FieldTypeSymbol, contentful.FieldTypeText:
+		return stringValues(field.Validations)
+	case contentful.FieldTypeArray:
+		return itemPredefinedValues(field)
+	default:
+		return nil
+	}
+}
+
+func itemPredefinedValues(field *contentful.Field) []string {
+	if field.Items == nil || (field.Items.Type != contentful.FieldTypeSymbol && field.Items.Type != contentful.FieldTypeText) {
+		return nil
+	}
+
+	return stringValues(field.Items.Validations)
+}
+
+func stringValues(validations []contentful.FieldValidation) []string {
+	for _, validation := range validations {
+		predefined, ok := validation.(contentful.FieldValidationPredefinedValues)
+		if !ok {
+			continue
+		}
+
+		values := make([]string, 0, len(predefined.In))
+		for _, value := range predefined.In {
+			if s, ok := value.(string); ok {
+				values = append(values, s)
+			}
+		}
+
+		return values
+	}
+
+	return nil
+}
+
+// identifier converts an id or a value into an exported Go identifier
+func identifier(s string) string {
+	var b strings.Builder
+	upper := true
+
+	for _, r := range s {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+
+		if upper {
+			b.WriteRune(unicode.ToUpper(r))
+			upper = false
+		} else {
+			b.WriteRune(r)
+		}
+	}
+
+	id := b.String()
+	if id == "" {
+		return "Value"
+	}
+
+	if unicode.IsDigit(rune(id[0])) {
+		return "X" + id
+	}
+
+	return id
+}
+
+// unique returns name, suffixed with a number when it is already used
+func unique(name string, used map[string]bool) string {
+	candidate := name
+	for i := 2; used[candidate]; i++ {
+		candidate = fmt.Sprintf("%s%d", name, i)
+	}
+
+	used[candidate] = true
+
+	return candidate
+}
+
+func comment(name, id string) string {
+	if name == "" {
+		return id
+	}
+
+	return oneLine(name)
+}
+
+func oneLine(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
@@ -0,0 +1,205 @@
+package main
+
+import (
+	"encoding/json"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"testing"
+
+	contentful "github.com/mborders/contentful-go"
+	"github.com/stretchr/testify/assert"
+)
+
+const contentTypesJSON = `[
+	{
+		"sys": {"id": "blogPost", "type": "ContentType"},
+		"name": "Blog Post",
+		"fields": [
+			{"id": "title", "name": "Title", "type": "Symbol"},
+			{"id": "status", "name": "Status", "type": "Symbol", "validations": [{"in": ["draft", "in-review", "published"]}]},
+			{"id": "rating", "name": "Rating", "type": "Number"},
+			{"id": "views", "name": "Views", "type": "Integer"},
+			{"id": "featured", "name": "Featured", "type": "Boolean"},
+			{"id": "publishDate", "name": "Publish date", "type": "Date"},
+			{"id": "location", "name": "Location", "type": "Location"},
+			{"id": "hero", "name": "Hero", "type": "Link", "linkType": "Asset"},
+			{"id": "author", "name": "Author", "type": "Link", "linkType": "Entry", "validations": [{"linkContentType": ["author"]}]},
+			{"id": "related", "name": "Related", "type": "Array", "items": {"type": "Link", "linkType": "Entry"}},
+			{"id": "tags", "name": "Tags", "type": "Array", "items": {"type": "Symbol", "validations": [{"in": ["go", "cms"]}]}},
+			{"id": "metadata", "name": "Metadata", "type": "Object"},
+			{"id": "body", "name": "Body", "type": "RichText"},
+			{"id": "legacy", "name": "Legacy", "type": "Symbol", "omitted": true}
+		]
+	},
+	{
+		"sys": {"id": "author", "type": "ContentType"},
+		"name": "Author",
+		"description": "is the writer of blog posts",
+		"fields": [
+			{"id": "name", "name": "Name", "type": "Symbol"}
+		]
+	}
+]`
+
+func testContentTypes(t *testing.T) []*contentful.ContentType {
+	var contentTypes []*contentful.ContentType
+	if err := json.Unmarshal([]byte(contentTypesJSON), &contentTypes); err != nil {
+		t.Fatal(err)
+	}
+
+	return contentTypes
+}
+
+func TestGenerate(t *testing.T) {
+	assertions := assert.New(t)
+
+	source, err := Generate("models", testContentTypes(t))
+	assertions.Nil(err)
+
+	code := string(source)
+	assertions.Contains(code, "// Code generated by contentful-gen. DO NOT EDIT.")
+	assertions.Contains(code, "package models")
+	assertions.Contains(code, `"time"`)
+
+	// constants
+	assertions.Contains(code, `ContentTypeBlogPost = "blogPost"`)
+	assertions.Contains(code, `BlogPostFieldPublishDate = "publishDate"`)
+
+	// enums
+	assertions.Contains(code, "type BlogPostStatus string")
+	assertions.Regexp(`BlogPostStatusInReview\s+BlogPostStatus = "in-review"`, code)
+	assertions.Regexp(`BlogPostTagsGo\s+BlogPostTags = "go"`, code)
+
+	// fields
+	assertions.Regexp(`Sys\s+\*contentful.Sys\s+`+"`"+`contentful:"sys"`, code)
+	assertions.Regexp(`Title\s+string\s+`+"`"+`contentful:"title" json:"title,omitempty"`+"`", code)
+	assertions.Regexp(`Status\s+BlogPostStatus\s`, code)
+	assertions.Regexp(`Rating\s+float64\s`, code)
+	assertions.Regexp(`Views\s+int\s`, code)
+	assertions.Regexp(`Featured\s+bool\s`, code)
+	assertions.Regexp(`PublishDate\s+time.Time\s`, code)
+	assertions.Regexp(`Location\s+\*contentful.Location\s`, code)
+	assertions.Regexp(`Hero\s+\*contentful.Asset\s`, code)
+	assertions.Regexp(`Author\s+\*Author\s`, code)
+	assertions.Regexp(`Related\s+\[\]\*contentful.Entry\s`, code)
+	assertions.Regexp(`Tags\s+\[\]BlogPostTags\s`, code)
+	assertions.Regexp(`Metadata\s+map\[string\]interface\{\}\s`, code)
+	assertions.Regexp(`Body\s+\*contentful.Document\s`, code)
+	assertions.NotContains(code, "Legacy")
+
+	// helpers
+	assertions.Contains(code, "// Author is the writer of blog posts")
+	assertions.Contains(code, "func DecodeBlogPost(entry *contentful.Entry, locale string) (*BlogPost, error)")
+	assertions.Contains(code, "func AuthorLink(id string) map[string]interface{}")
+}
+
+func TestGenerateCollisions(t *testing.T) {
+	assertions := assert.New(t)
+
+	var contentTypes []*contentful.ContentType
+	assertions.Nil(json.Unmarshal([]byte(`[
+		{"sys": {"id": "post"}, "fields": [{"id": "category", "type": "Symbol", "validations": [{"in": ["news", "News"]}]}]},
+		{"sys": {"id": "postCategory"}, "fields": [{"id": "name", "type": "Symbol"}]},
+		{"sys": {"id": "postLink"}, "fields": [{"id": "url", "type": "Symbol"}]},
+		{"sys": {"id": "Post-Category"}, "fields": []}
+	]`), &contentTypes))
+
+	source, err := Generate("models", contentTypes)
+	assertions.Nil(err)
+
+	// every top level declaration has its own name
+	file, err := parser.ParseFile(token.NewFileSet(), "models.go", source, 0)
+	assertions.Nil(err)
+
+	declared := map[string]int{}
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			declared[decl.Name.Name]++
+		case *ast.GenDecl:
+			for _, spec := range decl.Specs {
+				switch spec := spec.(type) {
+				case *ast.TypeSpec:
+					declared[spec.Name.Name]++
+				case *ast.ValueSpec:
+					for _, name := range spec.Names {
+						declared[name.Name]++
+					}
+				}
+			}
+		}
+	}
+
+	for name, count := range declared {
+		assertions.Equal(1, count, name)
+	}
+
+	// the models keep their names, the other declarations are suffixed
+	code := string(source)
+	assertions.Contains(code, "type PostCategory struct")
+	assertions.Contains(code, "type PostLink struct")
+	assertions.Contains(code, "type PostCategory3 string")
+	assertions.Regexp(`Category\s+PostCategory3\s`, code)
+	assertions.Contains(code, "func PostLink2(id string) map[string]interface{}")
+}
+
+func TestGenerateWithoutID(t *testing.T) {
+	assertions := assert.New(t)
+
+	_, err := Generate("models", []*contentful.ContentType{{Name: "Broken"}})
+	assertions.NotNil(err)
+}
+
+func TestIdentifier(t *testing.T) {
+	assertions := assert.New(t)
+
+	assertions.Equal("BlogPost", identifier("blogPost"))
+	assertions.Equal("InReview", identifier("in-review"))
+	assertions.Equal("X2col", identifier("2col"))
+	assertions.Equal("Value", identifier("--"))
+
+	used := map[string]bool{}
+	assertions.Equal("Title", unique("Title", used))
+	assertions.Equal("Title2", unique("Title", used))
+}
+
+func TestReadExport(t *testing.T) {
+	assertions := assert.New(t)
+
+	dir := t.TempDir()
+	formats := map[string]string{
+		"export.json":     `{"contentTypes": ` + contentTypesJSON + `}`,
+		"collection.json": `{"total": 2, "items": ` + contentTypesJSON + `}`,
+		"list.json":       contentTypesJSON,
+	}
+
+	for name, data := range formats {
+		path := filepath.Join(dir, name)
+		assertions.Nil(os.WriteFile(path, []byte(data), 0o644))
+
+		contentTypes, err := readExport(path)
+		assertions.Nil(err, name)
+		assertions.Len(contentTypes, 2, name)
+		assertions.Equal("author", contentTypes[1].Sys.ID, name)
+	}
+}
+
+func TestRun(t *testing.T) {
+	assertions := assert.New(t)
+
+	dir := t.TempDir()
+	input := filepath.Join(dir, "export.json")
+	output := filepath.Join(dir, "models.go")
+	assertions.Nil(os.WriteFile(input, []byte(contentTypesJSON), 0o644))
+
+	assertions.Nil(run([]string{"-file", input, "-package", "cms", "-o", output}, os.Stdout))
+
+	source, err := os.ReadFile(output)
+	assertions.Nil(err)
+	assertions.Contains(string(source), "package cms")
+
+	assertions.NotNil(run([]string{}, os.Stdout))
+}
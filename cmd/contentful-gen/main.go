@@ -0,0 +1,116 @@
+// Command contentful-gen generates Go models from Contentful content types.
+//
+// The content types are read from a space:
+//
+//	contentful-gen -space <space-id> -environment master -token <cma-token> -package models -o models.go
+//
+// or from a JSON export, as written by contentful-export or returned by the content types endpoint:
+//
+//	contentful-gen -file export.json -package models -o models.go
+//
+// For every content type, the generated code holds a struct to decode its
+// entries into, the ids of its fields, the allowed values of its fields as
+// enums, and helpers to decode entries and link to them.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	contentful "github.com/mborders/contentful-go"
+)
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, "contentful-gen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("contentful-gen", flag.ContinueOnError)
+	spaceID := flags.String("space", "", "id of the space to read the content types from")
+	environment := flags.String("environment", "master", "environment to read the content types from")
+	token := flags.String("token", os.Getenv("CONTENTFUL_MANAGEMENT_TOKEN"), "management api token, defaults to $CONTENTFUL_MANAGEMENT_TOKEN")
+	file := flags.String("file", "", "JSON export to read the content types from, instead of a space")
+	pkg := flags.String("package", "models", "package name of the generated code")
+	output := flags.String("o", "", "output file, defaults to stdout")
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	var contentTypes []*contentful.ContentType
+	var err error
+
+	switch {
+	case *file != "":
+		contentTypes, err = readExport(*file)
+	case *spaceID != "":
+		contentTypes, err = fetch(*token, *spaceID, *environment)
+	default:
+		return errors.New("either -space or -file is required")
+	}
+
+	if err != nil {
+		return err
+	}
+
+	source, err := Generate(*pkg, contentTypes)
+	if err != nil {
+		return err
+	}
+
+	if *output == "" {
+		_, err = stdout.Write(source)
+		return err
+	}
+
+	return os.WriteFile(*output, source, 0o644)
+}
+
+// fetch reads every content type of an environment
+func fetch(token, spaceID, environment string) ([]*contentful.ContentType, error) {
+	if token == "" {
+		return nil, errors.New("a management api token is required, use -token or $CONTENTFUL_MANAGEMENT_TOKEN")
+	}
+
+	cma := contentful.NewCMA(token)
+	cma.SetEnvironment(environment)
+
+	return contentful.All[*contentful.ContentType](context.Background(), cma.ContentTypes.List(spaceID))
+}
+
+// readExport reads the content types of a contentful-export file, of a
+// content types collection or of a plain list
+func readExport(path string) ([]*contentful.ContentType, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var export struct {
+		ContentTypes []*contentful.ContentType `json:"contentTypes"`
+		Items        []*contentful.ContentType `json:"items"`
+	}
+
+	if err := json.Unmarshal(data, &export); err == nil {
+		if export.ContentTypes != nil {
+			return export.ContentTypes, nil
+		}
+
+		return export.Items, nil
+	}
+
+	var contentTypes []*contentful.ContentType
+	if err := json.Unmarshal(data, &contentTypes); err != nil {
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+
+	return contentTypes, nil
+}
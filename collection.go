@@ -2,8 +2,26 @@ package contentful
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// PaginationMode defines how a collection requests its pages
+type PaginationMode int
+
+// noinspection GoUnusedConst
+const (
+	// PaginationSkip pages with skip and limit, the default mode
+	PaginationSkip PaginationMode = iota
+
+	// PaginationKeyset pages by sys.createdAt and sys.id, it is not bound to the skip limit of the api
+	PaginationKeyset
+
+	// PaginationCursor pages with the pageNext cursors returned by the api, on endpoints supporting it
+	PaginationCursor
 )
 
 // CollectionOptions holds init options
@@ -11,18 +29,37 @@ type CollectionOptions struct {
 	Limit uint16
 }
 
+// CollectionPages holds the cursors of a cursor paginated collection
+type CollectionPages struct {
+	Next string `json:"next,omitempty"`
+	Prev string `json:"prev,omitempty"`
+}
+
 // Collection model
 type Collection struct {
 	Query
-	c        *Client
-	req      *http.Request
-	page     uint16
-	Sys      *Sys          `json:"sys"`
-	Total    int           `json:"total"`
-	Skip     int           `json:"skip"`
-	Limit    int           `json:"limit"`
-	Items    []interface{} `json:"items"`
-	Includes Includes      `json:"includes"`
+	c                 *Client
+	req               *http.Request
+	path              func(environment string) string
+	filterEnvironment bool
+	page              int
+	pagination        PaginationMode
+	keyset            keysetCursor
+	Sys               *Sys             `json:"sys"`
+	Total             int              `json:"total"`
+	Skip              int              `json:"skip"`
+	Limit             int              `json:"limit"`
+	Items             []interface{}    `json:"items"`
+	Includes          Includes         `json:"includes"`
+	Pages             *CollectionPages `json:"pages,omitempty"`
+}
+
+// keysetCursor points after the last item of the previous page: items created
+// at createdAt are requested with sys.createdAt[gte], skipping the ones
+// already returned
+type keysetCursor struct {
+	createdAt string
+	skip      int
 }
 
 // NewCollection initializes a new collection
@@ -40,14 +77,83 @@ func NewCollection(options *CollectionOptions) *Collection {
 	}
 }
 
-// Next makes the col.req
-func (col *Collection) Next() (*Collection, error) {
+// environmentCollection returns a collection of an environment scoped
+// resource. The path of each page is built in the environment of the context
+// given to Next, see WithEnvironment, like the path of the other calls.
+func (c *Client) environmentCollection(path func(environment string) string) *Collection {
+	col := NewCollection(&CollectionOptions{})
+	col.c = c
+	col.path = path
+
+	return col
+}
+
+// WithKeysetPagination pages the collection by sys.createdAt and sys.id
+// instead of skip, so that collections of any size can be walked. Items are
+// returned from the oldest to the newest; since sys.createdAt never changes,
+// items updated during the walk are neither skipped nor repeated.
+func (col *Collection) WithKeysetPagination() *Collection {
+	col.pagination = PaginationKeyset
+	return col
+}
+
+// WithCursorPagination pages the collection with the pageNext cursors of the
+// api. Only endpoints supporting cursor based pagination can be used.
+func (col *Collection) WithCursorPagination() *Collection {
+	col.pagination = PaginationCursor
+	return col
+}
+
+// HasNext reports whether there are pages left after the last fetched one
+func (col *Collection) HasNext() bool {
+	if col.page == 1 {
+		return true
+	}
+
+	if col.pagination == PaginationCursor {
+		return col.Pages != nil && col.Pages.Next != ""
+	}
+
+	return len(col.Items) > 0 && col.Skip+len(col.Items) < col.Total
+}
+
+// Next makes the col.req, bound to the given context
+func (col *Collection) Next(ctx context.Context) (*Collection, error) {
 	// setup query params
-	skip := uint16(col.Limit) * (col.page - 1)
-	col.Query.Skip(skip)
+	var query url.Values
+
+	switch col.pagination {
+	case PaginationKeyset:
+		query = col.keysetQuery()
+	case PaginationCursor:
+		query = col.cursorQuery()
+	default:
+		col.Query.Offset(col.Limit * (col.page - 1))
+		query = col.Query.Values()
+	}
+
+	// environment scoped collections request each page in the environment of
+	// its context, space level ones may be filtered by it
+	if col.path != nil {
+		req, err := col.c.newRequest(ctx, http.MethodGet, col.path(col.c.environment(ctx)), nil, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		col.req = req
+	}
+
+	if col.filterEnvironment {
+		query.Set("environment.sys.id", col.c.environment(ctx))
+	}
+
+	// override request query and context
+	col.req = col.req.WithContext(ctx)
+	col.req.URL.RawQuery = query.Encode()
 
-	// override request query
-	col.req.URL.RawQuery = col.Query.String()
+	// reset page data which may be absent from the response
+	col.Items = nil
+	col.Pages = nil
 
 	// makes api call
 	err := col.c.do(col.req, col)
@@ -55,11 +161,75 @@ func (col *Collection) Next() (*Collection, error) {
 		return nil, err
 	}
 
+	if col.pagination == PaginationKeyset {
+		col.advanceKeyset()
+	}
+
 	col.page++
 
 	return col, nil
 }
 
+func (col *Collection) keysetQuery() url.Values {
+	query := col.Query.Values()
+	query.Set("order", "sys.createdAt,sys.id")
+	query.Del("skip")
+
+	if col.keyset.createdAt != "" {
+		query.Set("sys.createdAt[gte]", col.keyset.createdAt)
+	}
+
+	if col.keyset.skip > 0 {
+		query.Set("skip", strconv.Itoa(col.keyset.skip))
+	}
+
+	return query
+}
+
+// advanceKeyset moves the cursor after the last item of the fetched page
+func (col *Collection) advanceKeyset() {
+	for _, item := range col.Items {
+		createdAt := itemCreatedAt(item)
+		if createdAt == col.keyset.createdAt {
+			col.keyset.skip++
+			continue
+		}
+
+		col.keyset.createdAt = createdAt
+		col.keyset.skip = 1
+	}
+}
+
+func itemCreatedAt(item interface{}) string {
+	fields, ok := item.(map[string]interface{})
+	if !ok {
+		return ""
+	}
+
+	sys, ok := fields["sys"].(map[string]interface{})
+	if !ok {
+		return ""
+	}
+
+	createdAt, _ := sys["createdAt"].(string)
+
+	return createdAt
+}
+
+func (col *Collection) cursorQuery() url.Values {
+	query := col.Query.Values()
+	query.Del("skip")
+	query.Del("order")
+
+	if col.Pages != nil && col.Pages.Next != "" {
+		query.Set("pageNext", col.Pages.Next)
+	} else {
+		query.Set("cursor", "true")
+	}
+
+	return query
+}
+
 // ToContentType cast Items to ContentType model
 func (col *Collection) ToContentType() []*ContentType {
 	var contentTypes []*ContentType
@@ -210,6 +380,16 @@ func (col *Collection) ToScheduledAction() []*ScheduledAction {
 	return scheduledActions
 }
 
+// ToRelease cast Items to Release model
+func (col *Collection) ToRelease() []*Release {
+	var releases []*Release
+
+	byteArray, _ := json.Marshal(col.Items)
+	_ = json.NewDecoder(bytes.NewReader(byteArray)).Decode(&releases)
+
+	return releases
+}
+
 // ToEditorInterface cast Items to EditorInterface model
 func (col *Collection) ToEditorInterface() []*EditorInterface {
 	var editorInterface []*EditorInterface
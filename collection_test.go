@@ -1,8 +1,142 @@
 package contentful
 
-import "testing"
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
 
 func TestNewCollection(t *testing.T) {
 	setup()
 	defer teardown()
 }
+
+func TestCollectionNextSkipBeyondUint16(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal("99900", r.URL.Query().Get("skip"))
+
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, `{"total": 100000, "skip": 99900, "limit": 100, "items": []}`)
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.baseURL = server.URL
+
+	col := cma.Entries.List(spaceID)
+	col.Limit = 100
+	col.page = 1000
+
+	_, err := col.Next(context.Background())
+	assertions.Nil(err)
+}
+
+func TestCollectionKeysetPagination(t *testing.T) {
+	assertions := assert.New(t)
+
+	// several entries share a creation date, across page boundaries
+	createdAt := []string{
+		"2023-01-01T00:00:00.000Z",
+		"2023-01-01T00:00:00.000Z",
+		"2023-01-01T00:00:00.000Z",
+		"2023-01-02T00:00:00.000Z",
+		"2023-01-03T00:00:00.000Z",
+		"2023-01-03T00:00:00.000Z",
+		"2023-01-04T00:00:00.000Z",
+	}
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		assertions.Equal("sys.createdAt,sys.id", query.Get("order"))
+
+		var ids []int
+		for i, date := range createdAt {
+			if date >= query.Get("sys.createdAt[gte]") {
+				ids = append(ids, i)
+			}
+		}
+		sort.Ints(ids)
+
+		skip, _ := strconv.Atoi(query.Get("skip"))
+		var items []interface{}
+		for i := skip; i < skip+2 && i < len(ids); i++ {
+			items = append(items, map[string]interface{}{
+				"sys": map[string]interface{}{
+					"id":        fmt.Sprintf("entry-%d", ids[i]),
+					"createdAt": createdAt[ids[i]],
+				},
+			})
+		}
+
+		w.WriteHeader(200)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"total": len(ids),
+			"skip":  skip,
+			"limit": 2,
+			"items": items,
+		})
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.baseURL = server.URL
+
+	entries, err := All[*Entry](context.Background(), cma.Entries.List(spaceID).WithKeysetPagination())
+	assertions.Nil(err)
+
+	var ids []string
+	for _, entry := range entries {
+		ids = append(ids, entry.Sys.ID)
+	}
+	assertions.Equal([]string{"entry-0", "entry-1", "entry-2", "entry-3", "entry-4", "entry-5", "entry-6"}, ids)
+}
+
+func TestCollectionCursorPagination(t *testing.T) {
+	assertions := assert.New(t)
+
+	pages := map[string]string{
+		"":      `{"items": [{"sys": {"id": "entry-0"}}, {"sys": {"id": "entry-1"}}], "pages": {"next": "page2"}}`,
+		"page2": `{"items": [{"sys": {"id": "entry-2"}}], "pages": {"prev": "page1"}}`,
+	}
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if query.Get("pageNext") == "" {
+			assertions.Equal("true", query.Get("cursor"))
+		}
+		assertions.Empty(query.Get("skip"))
+
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, pages[query.Get("pageNext")])
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.baseURL = server.URL
+
+	entries, err := All[*Entry](context.Background(), cma.Entries.List(spaceID).WithCursorPagination())
+	assertions.Nil(err)
+	assertions.Len(entries, 3)
+	assertions.Equal("entry-2", entries[2].Sys.ID)
+}
@@ -0,0 +1,401 @@
+package contentful
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	// DiffAdded is the change of an item which only exists in the target
+	DiffAdded = "added"
+
+	// DiffRemoved is the change of an item which only exists in the source
+	DiffRemoved = "removed"
+
+	// DiffChanged is the change of an item whose properties differ
+	DiffChanged = "changed"
+)
+
+// ContentModel is the content model of an environment
+type ContentModel struct {
+	ContentTypes     []*ContentType
+	EditorInterfaces []*EditorInterface
+	Locales          []*Locale
+}
+
+// ContentModelDiff is the difference between two content models, from a
+// source to a target. It marshals to JSON, and String formats it as text.
+type ContentModelDiff struct {
+	Locales      []*LocaleDiff      `json:"locales"`
+	ContentTypes []*ContentTypeDiff `json:"contentTypes"`
+}
+
+// LocaleDiff is an added, removed or changed locale
+type LocaleDiff struct {
+	Code       string          `json:"code"`
+	Change     string          `json:"change"`
+	Properties []*PropertyDiff `json:"properties,omitempty"`
+
+	From *Locale `json:"-"`
+	To   *Locale `json:"-"`
+}
+
+// ContentTypeDiff is an added, removed or changed content type, with its
+// changed properties, fields and editor controls
+type ContentTypeDiff struct {
+	ID         string          `json:"id"`
+	Change     string          `json:"change"`
+	Properties []*PropertyDiff `json:"properties,omitempty"`
+	Fields     []*FieldDiff    `json:"fields,omitempty"`
+	Controls   []*ControlDiff  `json:"controls,omitempty"`
+
+	From                *ContentType     `json:"-"`
+	To                  *ContentType     `json:"-"`
+	FromEditorInterface *EditorInterface `json:"-"`
+	ToEditorInterface   *EditorInterface `json:"-"`
+}
+
+// FieldDiff is an added, removed or changed field
+type FieldDiff struct {
+	ID         string          `json:"id"`
+	Change     string          `json:"change"`
+	Properties []*PropertyDiff `json:"properties,omitempty"`
+
+	From *Field `json:"-"`
+	To   *Field `json:"-"`
+}
+
+// ControlDiff is an added, removed or changed editor control of a field
+type ControlDiff struct {
+	FieldID string    `json:"fieldId"`
+	Change  string    `json:"change"`
+	From    *Controls `json:"from,omitempty"`
+	To      *Controls `json:"to,omitempty"`
+}
+
+// PropertyDiff is a property whose value differs
+type PropertyDiff struct {
+	Name string      `json:"name"`
+	From interface{} `json:"from"`
+	To   interface{} `json:"to"`
+}
+
+// LoadContentModel loads the content types, editor interfaces and locales of
+// the environment of the client
+func LoadContentModel(ctx context.Context, c *Client, spaceID string) (*ContentModel, error) {
+	model := &ContentModel{}
+
+	var err error
+	if model.ContentTypes, err = All[*ContentType](ctx, c.ContentTypes.List(spaceID)); err != nil {
+		return nil, err
+	}
+
+	if model.EditorInterfaces, err = All[*EditorInterface](ctx, c.EditorInterfaces.List(spaceID)); err != nil {
+		return nil, err
+	}
+
+	if model.Locales, err = All[*Locale](ctx, c.Locales.List(spaceID)); err != nil {
+		return nil, err
+	}
+
+	return model, nil
+}
+
+// DiffEnvironments loads the content models of two environments of a space
+// and returns the changes from the first one to the second one
+func DiffEnvironments(ctx context.Context, c *Client, spaceID, fromEnvironment, toEnvironment string) (*ContentModelDiff, error) {
+	from, err := LoadContentModel(WithEnvironment(ctx, fromEnvironment), c.withEnvironment(fromEnvironment), spaceID)
+	if err != nil {
+		return nil, fmt.Errorf("contentful: loading %s: %w", fromEnvironment, err)
+	}
+
+	to, err := LoadContentModel(WithEnvironment(ctx, toEnvironment), c.withEnvironment(toEnvironment), spaceID)
+	if err != nil {
+		return nil, fmt.Errorf("contentful: loading %s: %w", toEnvironment, err)
+	}
+
+	return DiffContentModels(from, to), nil
+}
+
+// DiffContentModels returns the changes from a content model to another one.
+// Content types and locales are sorted by id and code, fields are in the
+// order of the target, followed by the removed ones.
+func DiffContentModels(from, to *ContentModel) *ContentModelDiff {
+	diff := &ContentModelDiff{Locales: []*LocaleDiff{}, ContentTypes: []*ContentTypeDiff{}}
+
+	fromLocales, toLocales := map[string]*Locale{}, map[string]*Locale{}
+	for _, locale := range from.Locales {
+		fromLocales[locale.Code] = locale
+	}
+	for _, locale := range to.Locales {
+		toLocales[locale.Code] = locale
+	}
+
+	for _, code := range sortedKeys(fromLocales, toLocales) {
+		if d := diffLocale(code, fromLocales[code], toLocales[code]); d != nil {
+			diff.Locales = append(diff.Locales, d)
+		}
+	}
+
+	fromTypes, toTypes := contentTypesByID(from), contentTypesByID(to)
+	fromInterfaces, toInterfaces := editorInterfacesByID(from), editorInterfacesByID(to)
+	for _, id := range sortedKeys(fromTypes, toTypes) {
+		d := diffContentType(id, fromTypes[id], toTypes[id], fromInterfaces[id], toInterfaces[id])
+		if d != nil {
+			diff.ContentTypes = append(diff.ContentTypes, d)
+		}
+	}
+
+	return diff
+}
+
+// Empty reports whether the content models are the same
+func (diff *ContentModelDiff) Empty() bool {
+	return len(diff.Locales) == 0 && len(diff.ContentTypes) == 0
+}
+
+// String formats the diff as text, one line per change: + for added, - for
+// removed and ~ for changed items, followed by the changed properties
+func (diff *ContentModelDiff) String() string {
+	if diff.Empty() {
+		return "No changes\n"
+	}
+
+	msg := strings.Builder{}
+	if len(diff.Locales) > 0 {
+		msg.WriteString("Locales\n")
+		for _, locale := range diff.Locales {
+			msg.WriteString(fmt.Sprintf("  %s %s\n", changeSymbol(locale.Change), locale.Code))
+			writeProperties(&msg, "      ", locale.Properties)
+		}
+	}
+
+	if len(diff.ContentTypes) > 0 {
+		msg.WriteString("Content types\n")
+		for _, contentType := range diff.ContentTypes {
+			msg.WriteString(fmt.Sprintf("  %s %s\n", changeSymbol(contentType.Change), contentType.ID))
+			writeProperties(&msg, "      ", contentType.Properties)
+
+			for _, field := range contentType.Fields {
+				msg.WriteString(fmt.Sprintf("      %s field %s\n", changeSymbol(field.Change), field.ID))
+				writeProperties(&msg, "          ", field.Properties)
+			}
+
+			for _, control := range contentType.Controls {
+				msg.WriteString(fmt.Sprintf("      %s control %s: %s -> %s\n", changeSymbol(control.Change), control.FieldID, formatControl(control.From), formatControl(control.To)))
+			}
+		}
+	}
+
+	return msg.String()
+}
+
+func diffLocale(code string, from, to *Locale) *LocaleDiff {
+	switch {
+	case from == nil:
+		return &LocaleDiff{Code: code, Change: DiffAdded, To: to}
+	case to == nil:
+		return &LocaleDiff{Code: code, Change: DiffRemoved, From: from}
+	}
+
+	var properties []*PropertyDiff
+	properties = diffProperty(properties, "name", from.Name, to.Name)
+	properties = diffProperty(properties, "fallbackCode", from.FallbackCode, to.FallbackCode)
+	properties = diffProperty(properties, "default", from.Default, to.Default)
+	properties = diffProperty(properties, "optional", from.Optional, to.Optional)
+	properties = diffProperty(properties, "contentDeliveryApi", from.CDA, to.CDA)
+	properties = diffProperty(properties, "contentManagementApi", from.CMA, to.CMA)
+
+	if len(properties) == 0 {
+		return nil
+	}
+
+	return &LocaleDiff{Code: code, Change: DiffChanged, Properties: properties, From: from, To: to}
+}
+
+func diffContentType(id string, from, to *ContentType, fromInterface, toInterface *EditorInterface) *ContentTypeDiff {
+	d := &ContentTypeDiff{ID: id, From: from, To: to, FromEditorInterface: fromInterface, ToEditorInterface: toInterface}
+
+	switch {
+	case from == nil:
+		d.Change = DiffAdded
+		return d
+	case to == nil:
+		d.Change = DiffRemoved
+		return d
+	}
+
+	d.Change = DiffChanged
+	d.Properties = diffProperty(d.Properties, "name", from.Name, to.Name)
+	d.Properties = diffProperty(d.Properties, "description", from.Description, to.Description)
+	d.Properties = diffProperty(d.Properties, "displayField", from.DisplayField, to.DisplayField)
+
+	fromFields := map[string]*Field{}
+	for _, field := range from.Fields {
+		fromFields[field.ID] = field
+	}
+
+	toFields := map[string]bool{}
+	for _, field := range to.Fields {
+		toFields[field.ID] = true
+		if fd := diffField(field.ID, fromFields[field.ID], field); fd != nil {
+			d.Fields = append(d.Fields, fd)
+		}
+	}
+
+	for _, field := range from.Fields {
+		if !toFields[field.ID] {
+			d.Fields = append(d.Fields, &FieldDiff{ID: field.ID, Change: DiffRemoved, From: field})
+		}
+	}
+
+	d.Controls = diffControls(fromInterface, toInterface)
+
+	if len(d.Properties) == 0 && len(d.Fields) == 0 && len(d.Controls) == 0 {
+		return nil
+	}
+
+	return d
+}
+
+func diffField(id string, from, to *Field) *FieldDiff {
+	if from == nil {
+		return &FieldDiff{ID: id, Change: DiffAdded, To: to}
+	}
+
+	var properties []*PropertyDiff
+	properties = diffProperty(properties, "name", from.Name, to.Name)
+	properties = diffProperty(properties, "type", from.Type, to.Type)
+	properties = diffProperty(properties, "linkType", from.LinkType, to.LinkType)
+	properties = diffProperty(properties, "items", from.Items, to.Items)
+	properties = diffProperty(properties, "localized", from.Localized, to.Localized)
+	properties = diffProperty(properties, "required", from.Required, to.Required)
+	properties = diffProperty(properties, "disabled", from.Disabled, to.Disabled)
+	properties = diffProperty(properties, "omitted", from.Omitted, to.Omitted)
+	if len(from.Validations) > 0 || len(to.Validations) > 0 {
+		properties = diffProperty(properties, "validations", from.Validations, to.Validations)
+	}
+
+	if len(properties) == 0 {
+		return nil
+	}
+
+	return &FieldDiff{ID: id, Change: DiffChanged, Properties: properties, From: from, To: to}
+}
+
+func diffControls(from, to *EditorInterface) []*ControlDiff {
+	fromControls, toControls := map[string]*Controls{}, map[string]*Controls{}
+	if from != nil {
+		for i := range from.Controls {
+			fromControls[from.Controls[i].FieldID] = &from.Controls[i]
+		}
+	}
+	if to != nil {
+		for i := range to.Controls {
+			toControls[to.Controls[i].FieldID] = &to.Controls[i]
+		}
+	}
+
+	var controls []*ControlDiff
+	for _, fieldID := range sortedKeys(fromControls, toControls) {
+		fromControl, toControl := fromControls[fieldID], toControls[fieldID]
+		switch {
+		case fromControl == nil:
+			controls = append(controls, &ControlDiff{FieldID: fieldID, Change: DiffAdded, To: toControl})
+		case toControl == nil:
+			controls = append(controls, &ControlDiff{FieldID: fieldID, Change: DiffRemoved, From: fromControl})
+		case !sameJSON(fromControl, toControl):
+			controls = append(controls, &ControlDiff{FieldID: fieldID, Change: DiffChanged, From: fromControl, To: toControl})
+		}
+	}
+
+	return controls
+}
+
+// diffProperty appends the property when its values differ
+func diffProperty(properties []*PropertyDiff, name string, from, to interface{}) []*PropertyDiff {
+	if sameJSON(from, to) {
+		return properties
+	}
+
+	return append(properties, &PropertyDiff{Name: name, From: from, To: to})
+}
+
+func contentTypesByID(model *ContentModel) map[string]*ContentType {
+	contentTypes := map[string]*ContentType{}
+	for _, contentType := range model.ContentTypes {
+		if contentType.Sys != nil {
+			contentTypes[contentType.Sys.ID] = contentType
+		}
+	}
+
+	return contentTypes
+}
+
+// editorInterfacesByID returns the editor interfaces by the id of their content type
+func editorInterfacesByID(model *ContentModel) map[string]*EditorInterface {
+	editorInterfaces := map[string]*EditorInterface{}
+	for _, editorInterface := range model.EditorInterfaces {
+		if sys := editorInterface.Sys; sys != nil && sys.ContentType != nil && sys.ContentType.Sys != nil {
+			editorInterfaces[sys.ContentType.Sys.ID] = editorInterface
+		}
+	}
+
+	return editorInterfaces
+}
+
+// sortedKeys returns the keys of both maps, sorted
+func sortedKeys[T any](a, b map[string]T) []string {
+	seen := map[string]bool{}
+	var keys []string
+	for _, m := range []map[string]T{a, b} {
+		for key := range m {
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+func changeSymbol(change string) string {
+	switch change {
+	case DiffAdded:
+		return "+"
+	case DiffRemoved:
+		return "-"
+	default:
+		return "~"
+	}
+}
+
+func writeProperties(msg *strings.Builder, indent string, properties []*PropertyDiff) {
+	for _, property := range properties {
+		msg.WriteString(fmt.Sprintf("%s%s: %s -> %s\n", indent, property.Name, formatValue(property.From), formatValue(property.To)))
+	}
+}
+
+// formatValue formats a value as compact JSON
+func formatValue(value interface{}) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+
+	return string(data)
+}
+
+func formatControl(control *Controls) string {
+	if control == nil {
+		return "none"
+	}
+
+	return control.WidgetNameSpace + "/" + control.WidgetID
+}
@@ -0,0 +1,175 @@
+package contentful
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// stagingModel and masterModel differ by a locale, a content type, fields and a control
+func masterModel() *ContentModel {
+	return &ContentModel{
+		Locales: []*Locale{{Code: "en-US", Name: "English", Default: true, CDA: true, CMA: true}},
+		ContentTypes: []*ContentType{
+			{
+				Sys:          &Sys{ID: "post"},
+				Name:         "Post",
+				DisplayField: "title",
+				Fields: []*Field{
+					{ID: "title", Name: "Title", Type: FieldTypeSymbol},
+					{ID: "body", Name: "Body", Type: FieldTypeText},
+					{ID: "legacy", Name: "Legacy", Type: FieldTypeSymbol},
+				},
+			},
+			{Sys: &Sys{ID: "banner"}, Name: "Banner"},
+		},
+		EditorInterfaces: []*EditorInterface{
+			{
+				Sys:      &Sys{ID: "default", ContentType: &ContentType{Sys: &Sys{ID: "post"}}},
+				Controls: []Controls{{FieldID: "title", WidgetNameSpace: "builtin", WidgetID: "singleLine"}},
+			},
+		},
+	}
+}
+
+func stagingModel() *ContentModel {
+	return &ContentModel{
+		Locales: []*Locale{
+			{Code: "en-US", Name: "English", Default: true, CDA: true, CMA: true},
+			{Code: "de-DE", Name: "German", FallbackCode: "en-US", CDA: true, CMA: true},
+		},
+		ContentTypes: []*ContentType{
+			{
+				Sys:          &Sys{ID: "post"},
+				Name:         "Article",
+				DisplayField: "title",
+				Fields: []*Field{
+					{ID: "title", Name: "Title", Type: FieldTypeSymbol, Required: true, Validations: []FieldValidation{&FieldValidationUnique{Unique: true}}},
+					{ID: "slug", Name: "Slug", Type: FieldTypeSymbol},
+					{ID: "body", Name: "Body", Type: FieldTypeText, Localized: true},
+				},
+			},
+			{Sys: &Sys{ID: "tag"}, Name: "Tag"},
+		},
+		EditorInterfaces: []*EditorInterface{
+			{
+				Sys: &Sys{ID: "default", ContentType: &ContentType{Sys: &Sys{ID: "post"}}},
+				Controls: []Controls{
+					{FieldID: "title", WidgetNameSpace: "builtin", WidgetID: "singleLine"},
+					{FieldID: "slug", WidgetNameSpace: "builtin", WidgetID: "slugEditor"},
+				},
+			},
+		},
+	}
+}
+
+func TestDiffContentModels(t *testing.T) {
+	assertions := assert.New(t)
+
+	diff := DiffContentModels(masterModel(), stagingModel())
+	assertions.False(diff.Empty())
+
+	assertions.Len(diff.Locales, 1)
+	assertions.Equal("de-DE", diff.Locales[0].Code)
+	assertions.Equal(DiffAdded, diff.Locales[0].Change)
+
+	assertions.Len(diff.ContentTypes, 3)
+	banner, post, tag := diff.ContentTypes[0], diff.ContentTypes[1], diff.ContentTypes[2]
+	assertions.Equal(DiffRemoved, banner.Change)
+	assertions.Equal(DiffAdded, tag.Change)
+	assertions.Equal("Tag", tag.To.Name)
+
+	assertions.Equal(DiffChanged, post.Change)
+	assertions.Equal([]*PropertyDiff{{Name: "name", From: "Post", To: "Article"}}, post.Properties)
+
+	assertions.Len(post.Fields, 4)
+	assertions.Equal("title", post.Fields[0].ID)
+	assertions.Equal(DiffChanged, post.Fields[0].Change)
+	assertions.Equal("required", post.Fields[0].Properties[0].Name)
+	assertions.Equal("validations", post.Fields[0].Properties[1].Name)
+	assertions.Equal(&FieldDiff{ID: "slug", Change: DiffAdded, To: post.To.Fields[1]}, post.Fields[1])
+	assertions.Equal([]*PropertyDiff{{Name: "localized", From: false, To: true}}, post.Fields[2].Properties)
+	assertions.Equal(DiffRemoved, post.Fields[3].Change)
+
+	assertions.Len(post.Controls, 1)
+	assertions.Equal("slug", post.Controls[0].FieldID)
+	assertions.Equal(DiffAdded, post.Controls[0].Change)
+
+	assertions.Equal(`Locales
+  + de-DE
+Content types
+  - banner
+  ~ post
+      name: "Post" -> "Article"
+      ~ field title
+          required: false -> true
+          validations: null -> [{"unique":true}]
+      + field slug
+      ~ field body
+          localized: false -> true
+      - field legacy
+      + control slug: none -> builtin/slugEditor
+  + tag
+`, diff.String())
+
+	assertions.True(DiffContentModels(masterModel(), masterModel()).Empty())
+	assertions.Equal("No changes\n", DiffContentModels(masterModel(), masterModel()).String())
+}
+
+func TestDiffContentModels_JSON(t *testing.T) {
+	assertions := assert.New(t)
+
+	data, err := json.Marshal(DiffContentModels(masterModel(), stagingModel()))
+	assertions.Nil(err)
+
+	var payload map[string][]map[string]interface{}
+	assertions.Nil(json.Unmarshal(data, &payload))
+	assertions.Equal("de-DE", payload["locales"][0]["code"])
+	assertions.Equal("removed", payload["contentTypes"][0]["change"])
+
+	fields := payload["contentTypes"][1]["fields"].([]interface{})
+	title := fields[0].(map[string]interface{})
+	assertions.Equal("title", title["id"])
+	assertions.Equal(map[string]interface{}{"name": "required", "from": false, "to": true}, title["properties"].([]interface{})[0])
+	assertions.NotContains(string(data), `"From"`)
+}
+
+func TestSpaceScope_DiffEnvironments(t *testing.T) {
+	assertions := assert.New(t)
+
+	models := map[string]*ContentModel{"master": masterModel(), "staging": stagingModel()}
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		checkHeaders(r, assertions)
+
+		parts := strings.Split(r.URL.Path, "/")
+		model := models[parts[4]]
+
+		var items interface{}
+		total := 0
+		switch parts[5] {
+		case "content_types":
+			items, total = model.ContentTypes, len(model.ContentTypes)
+		case "editor_interfaces":
+			items, total = model.EditorInterfaces, len(model.EditorInterfaces)
+		case "locales":
+			items, total = model.Locales, len(model.Locales)
+		}
+
+		w.WriteHeader(200)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"total": total, "items": items})
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	cma = NewCMA(CMAToken, WithBaseURL(server.URL))
+
+	diff, err := cma.Space(spaceID).DiffEnvironments(context.Background(), "master", "staging")
+	assertions.Nil(err)
+	assertions.Equal(DiffContentModels(masterModel(), stagingModel()).String(), diff.String())
+}
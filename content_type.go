@@ -2,6 +2,7 @@ package contentful
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"strconv"
@@ -47,6 +48,12 @@ const (
 
 	// FieldTypeObject content type field type for object data
 	FieldTypeObject = "Object"
+
+	// FieldTypeNumber content type field type for decimal number data
+	FieldTypeNumber = "Number"
+
+	// FieldTypeRichText content type field type for rich text data
+	FieldTypeRichText = "RichText"
 )
 
 // Field model
@@ -61,6 +68,12 @@ type Field struct {
 	Disabled    bool                `json:"disabled,omitempty"`
 	Omitted     bool                `json:"omitted,omitempty"`
 	Validations []FieldValidation   `json:"validations,omitempty"`
+
+	// Deleted deletes the field, which must have been omitted, when the content type is updated
+	Deleted bool `json:"deleted,omitempty"`
+
+	// NewID changes the id of the field when the content type is updated
+	NewID string `json:"newId,omitempty"`
 }
 
 // UnmarshalJSON for custom json unmarshaling
@@ -116,6 +129,14 @@ func (field *Field) UnmarshalJSON(data []byte) error {
 		field.Omitted = val.(bool)
 	}
 
+	if val, ok := payload["deleted"]; ok {
+		field.Deleted = val.(bool)
+	}
+
+	if val, ok := payload["newId"]; ok {
+		field.NewID = val.(string)
+	}
+
 	if val, ok := payload["validations"]; ok {
 		validations, err := ParseValidations(val.([]interface{}))
 		if err != nil {
@@ -128,118 +149,27 @@ func (field *Field) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// ParseValidations converts json representation to go struct
+// ParseValidations converts json representation to go struct. Validations
+// without a type in this package are kept as FieldValidationUnknown.
 func ParseValidations(data []interface{}) (validations []FieldValidation, err error) {
 	for _, value := range data {
-		var validation map[string]interface{}
 		var byteArray []byte
 
 		if validationStr, ok := value.(string); ok {
-			if err := json.Unmarshal([]byte(validationStr), &validation); err != nil {
-				return nil, err
-			}
-
 			byteArray = []byte(validationStr)
-		}
-
-		if validationMap, ok := value.(map[string]interface{}); ok {
-			byteArray, err = json.Marshal(validationMap)
+		} else {
+			byteArray, err = json.Marshal(value)
 			if err != nil {
 				return nil, err
 			}
-
-			validation = validationMap
-		}
-
-		if _, ok := validation["linkContentType"]; ok {
-			var fieldValidationLink FieldValidationLink
-			if err := json.Unmarshal(byteArray, &fieldValidationLink); err != nil {
-				return nil, err
-			}
-
-			validations = append(validations, fieldValidationLink)
 		}
 
-		if _, ok := validation["linkMimetypeGroup"]; ok {
-			var fieldValidationMimeType FieldValidationMimeType
-			if err := json.Unmarshal(byteArray, &fieldValidationMimeType); err != nil {
-				return nil, err
-			}
-
-			validations = append(validations, fieldValidationMimeType)
-		}
-
-		if _, ok := validation["assetImageDimensions"]; ok {
-			var fieldValidationDimension FieldValidationDimension
-			if err := json.Unmarshal(byteArray, &fieldValidationDimension); err != nil {
-				return nil, err
-			}
-
-			validations = append(validations, fieldValidationDimension)
-		}
-
-		if _, ok := validation["assetFileSize"]; ok {
-			var fieldValidationFileSize FieldValidationFileSize
-			if err := json.Unmarshal(byteArray, &fieldValidationFileSize); err != nil {
-				return nil, err
-			}
-
-			validations = append(validations, fieldValidationFileSize)
-		}
-
-		if _, ok := validation["unique"]; ok {
-			var fieldValidationUnique FieldValidationUnique
-			if err := json.Unmarshal(byteArray, &fieldValidationUnique); err != nil {
-				return nil, err
-			}
-
-			validations = append(validations, fieldValidationUnique)
-		}
-
-		if _, ok := validation["in"]; ok {
-			var fieldValidationPredefinedValues FieldValidationPredefinedValues
-			if err := json.Unmarshal(byteArray, &fieldValidationPredefinedValues); err != nil {
-				return nil, err
-			}
-
-			validations = append(validations, fieldValidationPredefinedValues)
-		}
-
-		if _, ok := validation["range"]; ok {
-			var fieldValidationRange FieldValidationRange
-			if err := json.Unmarshal(byteArray, &fieldValidationRange); err != nil {
-				return nil, err
-			}
-
-			validations = append(validations, fieldValidationRange)
-		}
-
-		if _, ok := validation["dateRange"]; ok {
-			var fieldValidationDate FieldValidationDate
-			if err := json.Unmarshal(byteArray, &fieldValidationDate); err != nil {
-				return nil, err
-			}
-
-			validations = append(validations, fieldValidationDate)
-		}
-
-		if _, ok := validation["size"]; ok {
-			var fieldValidationSize FieldValidationSize
-			if err := json.Unmarshal(byteArray, &fieldValidationSize); err != nil {
-				return nil, err
-			}
-
-			validations = append(validations, fieldValidationSize)
+		validation, err := parseValidation(byteArray)
+		if err != nil {
+			return nil, err
 		}
 
-		if _, ok := validation["regexp"]; ok {
-			var fieldValidationRegex FieldValidationRegex
-			if err := json.Unmarshal(byteArray, &fieldValidationRegex); err != nil {
-				return nil, err
-			}
-
-			validations = append(validations, fieldValidationRegex)
-		}
+		validations = append(validations, validation)
 	}
 
 	return validations, nil
@@ -272,7 +202,7 @@ func (item *FieldTypeArrayItem) UnmarshalJSON(data []byte) error {
 		item.Validations = validations
 	}
 
-	if val, ok := payload["linktype"]; ok {
+	if val, ok := payload["linkType"]; ok {
 		item.LinkType = val.(string)
 	}
 
@@ -291,54 +221,34 @@ func (ct *ContentType) GetVersion() int {
 
 // List return a content type collection
 func (service *ContentTypesService) List(spaceID string) *Collection {
-	path := fmt.Sprintf("/spaces/%s/content_types", spaceID)
-	method := "GET"
-
-	req, err := service.c.newRequest(method, path, nil, nil)
-	if err != nil {
-		return nil
-	}
-
-	col := NewCollection(&CollectionOptions{})
-	col.c = service.c
-	col.req = req
-
-	return col
+	return service.c.environmentCollection(func(environment string) string {
+		return fmt.Sprintf("/spaces/%s/environments/%s/content_types", spaceID, environment)
+	})
 }
 
 // ListActivated return a content type collection, with only activated content types
 func (service *ContentTypesService) ListActivated(spaceID string) *Collection {
-	path := fmt.Sprintf("/spaces/%s/public/content_types", spaceID)
-	method := "GET"
-
-	req, err := service.c.newRequest(method, path, nil, nil)
-	if err != nil {
-		return nil
-	}
-
-	col := NewCollection(&CollectionOptions{})
-	col.c = service.c
-	col.req = req
-
-	return col
+	return service.c.environmentCollection(func(environment string) string {
+		return fmt.Sprintf("/spaces/%s/environments/%s/public/content_types", spaceID, environment)
+	})
 }
 
 // Get fetched a content type specified by `contentTypeID`
-func (service *ContentTypesService) Get(spaceID, contentTypeID string) (*ContentType, error) {
-	path := fmt.Sprintf("/spaces/%s/content_types/%s", spaceID, contentTypeID)
+func (service *ContentTypesService) Get(ctx context.Context, spaceID, contentTypeID string) (*ContentType, error) {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/content_types/%s", spaceID, service.c.environment(ctx), contentTypeID)
 
-	return service.doGet(path)
+	return service.doGet(ctx, path)
 }
 
 // GetFromEnv a content type by `contentTypeID` from an environment
-func (service *ContentTypesService) GetWithEnv(env *Environment, contentTypeID string) (*ContentType, error) {
+func (service *ContentTypesService) GetWithEnv(ctx context.Context, env *Environment, contentTypeID string) (*ContentType, error) {
 	path := fmt.Sprintf("/spaces/%s/environments/%s/content_types/%s", env.Sys.Space.Sys.ID, env.Sys.ID, contentTypeID)
 
-	return service.doGet(path)
+	return service.doGet(ctx, path)
 }
 
-func (service *ContentTypesService) doGet(path string) (*ContentType, error) {
-	req, err := service.c.newRequest("GET", path, nil, nil)
+func (service *ContentTypesService) doGet(ctx context.Context, path string) (*ContentType, error) {
+	req, err := service.c.newRequest(ctx, "GET", path, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -352,20 +262,20 @@ func (service *ContentTypesService) doGet(path string) (*ContentType, error) {
 }
 
 // Upsert updates or creates a new content type
-func (service *ContentTypesService) Upsert(spaceID string, ct *ContentType) error {
+func (service *ContentTypesService) Upsert(ctx context.Context, spaceID string, ct *ContentType) error {
 	var path string
 
 	if ct.Sys != nil && ct.Sys.ID != "" {
-		path = fmt.Sprintf("/spaces/%s/content_types/%s", spaceID, ct.Sys.ID)
+		path = fmt.Sprintf("/spaces/%s/environments/%s/content_types/%s", spaceID, service.c.environment(ctx), ct.Sys.ID)
 	} else {
-		path = fmt.Sprintf("/spaces/%s/content_types/%s", spaceID, ct.Name)
+		path = fmt.Sprintf("/spaces/%s/environments/%s/content_types/%s", spaceID, service.c.environment(ctx), ct.Name)
 	}
 
-	return service.doUpsert(path, ct)
+	return service.doUpsert(ctx, path, ct)
 }
 
 // UpsertEnv a content type for an environment
-func (service *ContentTypesService) UpsertWithEnv(env *Environment, ct *ContentType) error {
+func (service *ContentTypesService) UpsertWithEnv(ctx context.Context, env *Environment, ct *ContentType) error {
 	var path string
 
 	path = fmt.Sprintf("/spaces/%s/environments/%s", env.Sys.Space.Sys.ID, env.Sys.ID)
@@ -376,16 +286,16 @@ func (service *ContentTypesService) UpsertWithEnv(env *Environment, ct *ContentT
 		path = fmt.Sprintf("%s/content_types/%s", path, ct.Name)
 	}
 
-	return service.doUpsert(path, ct)
+	return service.doUpsert(ctx, path, ct)
 }
 
-func (service *ContentTypesService) doUpsert(path string, ct *ContentType) error {
+func (service *ContentTypesService) doUpsert(ctx context.Context, path string, ct *ContentType) error {
 	bytesArray, err := json.Marshal(ct)
 	if err != nil {
 		return err
 	}
 
-	req, err := service.c.newRequest("PUT", path, nil, bytes.NewReader(bytesArray))
+	req, err := service.c.newRequest(ctx, "PUT", path, nil, bytes.NewReader(bytesArray))
 	if err != nil {
 		return err
 	}
@@ -396,21 +306,21 @@ func (service *ContentTypesService) doUpsert(path string, ct *ContentType) error
 }
 
 // Delete the content_type
-func (service *ContentTypesService) Delete(spaceID string, ct *ContentType) error {
-	path := fmt.Sprintf("/spaces/%s/content_types/%s", spaceID, ct.Sys.ID)
-	return service.doDelete(path, ct)
+func (service *ContentTypesService) Delete(ctx context.Context, spaceID string, ct *ContentType) error {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/content_types/%s", spaceID, service.c.environment(ctx), ct.Sys.ID)
+	return service.doDelete(ctx, path, ct)
 }
 
 // DeleteFromEnv a content type from an environment
-func (service *ContentTypesService) DeleteWithEnv(env *Environment, ct *ContentType) error {
+func (service *ContentTypesService) DeleteWithEnv(ctx context.Context, env *Environment, ct *ContentType) error {
 	path := fmt.Sprintf("/spaces/%s/environments/%s/content_types/%s", env.Sys.Space.Sys.ID, env.Sys.ID, ct.Sys.ID)
-	return service.doDelete(path, ct)
+	return service.doDelete(ctx, path, ct)
 }
 
-func (service *ContentTypesService) doDelete(path string, ct *ContentType) error {
+func (service *ContentTypesService) doDelete(ctx context.Context, path string, ct *ContentType) error {
 	method := "DELETE"
 
-	req, err := service.c.newRequest(method, path, nil, nil)
+	req, err := service.c.newRequest(ctx, method, path, nil, nil)
 	if err != nil {
 		return err
 	}
@@ -422,21 +332,21 @@ func (service *ContentTypesService) doDelete(path string, ct *ContentType) error
 }
 
 // Activate a contenttype, a.k.a publish
-func (service *ContentTypesService) Activate(spaceID string, ct *ContentType) error {
-	path := fmt.Sprintf("/spaces/%s/content_types/%s/published", spaceID, ct.Sys.ID)
-	return service.doActivate(path, ct)
+func (service *ContentTypesService) Activate(ctx context.Context, spaceID string, ct *ContentType) error {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/content_types/%s/published", spaceID, service.c.environment(ctx), ct.Sys.ID)
+	return service.doActivate(ctx, path, ct)
 }
 
 // Activate a contenttype in a specific environment, a.k.a publish
-func (service *ContentTypesService) ActivateWithEnv(env *Environment, ct *ContentType) error {
+func (service *ContentTypesService) ActivateWithEnv(ctx context.Context, env *Environment, ct *ContentType) error {
 	path := fmt.Sprintf("/spaces/%s/environments/%s/content_types/%s/published", env.Sys.Space.Sys.ID, env.Sys.ID, ct.Sys.ID)
-	return service.doActivate(path, ct)
+	return service.doActivate(ctx, path, ct)
 }
 
-func (service *ContentTypesService) doActivate(path string, ct *ContentType) error {
+func (service *ContentTypesService) doActivate(ctx context.Context, path string, ct *ContentType) error {
 	method := "PUT"
 
-	req, err := service.c.newRequest(method, path, nil, nil)
+	req, err := service.c.newRequest(ctx, method, path, nil, nil)
 	if err != nil {
 		return err
 	}
@@ -448,21 +358,21 @@ func (service *ContentTypesService) doActivate(path string, ct *ContentType) err
 }
 
 // Deactivate a contenttype, a.k.a unpublish
-func (service *ContentTypesService) Deactivate(spaceID string, ct *ContentType) error {
-	path := fmt.Sprintf("/spaces/%s/content_types/%s/published", spaceID, ct.Sys.ID)
-	return service.doDeactivate(path, ct)
+func (service *ContentTypesService) Deactivate(ctx context.Context, spaceID string, ct *ContentType) error {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/content_types/%s/published", spaceID, service.c.environment(ctx), ct.Sys.ID)
+	return service.doDeactivate(ctx, path, ct)
 }
 
 // Deactivate a contenttype in a specific environment, a.k.a unpublish
-func (service *ContentTypesService) DeactivateWithEnv(env *Environment, ct *ContentType) error {
+func (service *ContentTypesService) DeactivateWithEnv(ctx context.Context, env *Environment, ct *ContentType) error {
 	path := fmt.Sprintf("/spaces/%s/environments/%s/content_types/%s/published", env.Sys.Space.Sys.ID, env.Sys.ID, ct.Sys.ID)
-	return service.doDeactivate(path, ct)
+	return service.doDeactivate(ctx, path, ct)
 }
 
-func (service *ContentTypesService) doDeactivate(path string, ct *ContentType) error {
+func (service *ContentTypesService) doDeactivate(ctx context.Context, path string, ct *ContentType) error {
 	method := "DELETE"
 
-	req, err := service.c.newRequest(method, path, nil, nil)
+	req, err := service.c.newRequest(ctx, method, path, nil, nil)
 	if err != nil {
 		return err
 	}
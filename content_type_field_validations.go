@@ -2,15 +2,187 @@ package contentful
 
 import (
 	"encoding/json"
+	"sort"
 	"time"
 )
 
-// FieldValidation interface
-type FieldValidation interface{}
+// FieldValidation is a validation of a field, or of the items of an array
+// field. The set of validations is closed: each one is identified by the key
+// of its json representation, and validations without a type in this package
+// are parsed as FieldValidationUnknown and saved back verbatim.
+type FieldValidation interface {
+	validationName() string
+}
+
+// fieldValidationDecoders decode the validations by json key
+var fieldValidationDecoders = map[string]func(data []byte) (FieldValidation, error){
+	"linkContentType":      decodeValidation[FieldValidationLink],
+	"linkMimetypeGroup":    decodeValidation[FieldValidationMimeType],
+	"assetImageDimensions": decodeValidation[FieldValidationDimension],
+	"assetFileSize":        decodeValidation[FieldValidationFileSize],
+	"unique":               decodeValidation[FieldValidationUnique],
+	"in":                   decodeValidation[FieldValidationPredefinedValues],
+	"range":                decodeValidation[FieldValidationRange],
+	"dateRange":            decodeValidation[FieldValidationDate],
+	"size":                 decodeValidation[FieldValidationSize],
+	"regexp":               decodeValidation[FieldValidationRegex],
+	"prohibitRegexp":       decodeValidation[FieldValidationProhibitRegex],
+	"enabledNodeTypes":     decodeValidation[FieldValidationEnabledNodeTypes],
+	"enabledMarks":         decodeValidation[FieldValidationEnabledMarks],
+	"nodes":                decodeValidation[FieldValidationNodes],
+}
+
+// decodeValidation decodes a validation into its type, keeping the keys its
+// type does not encode so that they are saved back verbatim
+func decodeValidation[T FieldValidation](data []byte) (FieldValidation, error) {
+	var validation T
+	if err := json.Unmarshal(data, &validation); err != nil {
+		return nil, err
+	}
+
+	payload := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return nil, err
+	}
+
+	encoded, err := json.Marshal(validation)
+	if err != nil {
+		return nil, err
+	}
+
+	known := map[string]json.RawMessage{}
+	if err := json.Unmarshal(encoded, &known); err != nil {
+		return nil, err
+	}
+
+	extra := map[string]json.RawMessage{}
+	for key, value := range payload {
+		if _, ok := known[key]; !ok {
+			extra[key] = value
+		}
+	}
+
+	if len(extra) > 0 {
+		interface{}(&validation).(interface {
+			setExtra(extra map[string]json.RawMessage)
+		}).setExtra(extra)
+	}
+
+	return validation, nil
+}
+
+// validationExtra holds the keys of a validation read from the api which its
+// type does not encode
+type validationExtra struct {
+	extra map[string]json.RawMessage
+}
+
+func (e *validationExtra) setExtra(extra map[string]json.RawMessage) {
+	e.extra = extra
+}
+
+// marshalValidation encodes a validation along with the extra keys it was
+// read with, the keys of its type taking precedence
+func marshalValidation(v interface{}, extra map[string]json.RawMessage) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil || len(extra) == 0 {
+		return data, err
+	}
+
+	payload := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return nil, err
+	}
+
+	for key, value := range extra {
+		if _, ok := payload[key]; !ok {
+			payload[key] = value
+		}
+	}
+
+	return json.Marshal(payload)
+}
+
+// parseValidation decodes the json representation of a validation into its
+// type, by its first known key
+func parseValidation(data []byte) (FieldValidation, error) {
+	payload := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(payload))
+	for key := range payload {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if decode, ok := fieldValidationDecoders[key]; ok {
+			return decode(data)
+		}
+	}
+
+	return FieldValidationUnknown{Raw: append(json.RawMessage(nil), data...)}, nil
+}
+
+// FieldValidationUnknown is a validation without a type in this package. Its
+// json representation is kept verbatim, so that a content type read from the
+// api is saved unchanged.
+type FieldValidationUnknown struct {
+	Raw json.RawMessage
+}
+
+// validationName is the first key of the validation other than its message,
+// in sorted order
+func (v FieldValidationUnknown) validationName() string {
+	payload := map[string]json.RawMessage{}
+	_ = json.Unmarshal(v.Raw, &payload)
+	delete(payload, "message")
+
+	keys := make([]string, 0, len(payload))
+	for key := range payload {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	if len(keys) == 0 {
+		return ""
+	}
+
+	return keys[0]
+}
+
+// MarshalJSON for custom json marshaling
+func (v FieldValidationUnknown) MarshalJSON() ([]byte, error) {
+	if len(v.Raw) == 0 {
+		return []byte("{}"), nil
+	}
+
+	return v.Raw, nil
+}
+
+// UnmarshalJSON for custom json unmarshaling
+func (v *FieldValidationUnknown) UnmarshalJSON(data []byte) error {
+	v.Raw = append(json.RawMessage(nil), data...)
+
+	return nil
+}
 
 // FieldValidationLink model
 type FieldValidationLink struct {
-	LinkContentType []string `json:"linkContentType,omitempty"`
+	LinkContentType []string `json:"linkContentType"`
+	ErrorMessage    string   `json:"message,omitempty"`
+
+	validationExtra
+}
+
+func (v FieldValidationLink) validationName() string { return "linkContentType" }
+
+// MarshalJSON for custom json marshaling
+func (v FieldValidationLink) MarshalJSON() ([]byte, error) {
+	type validation FieldValidationLink
+	return marshalValidation(validation(v), v.extra)
 }
 
 const (
@@ -53,19 +225,89 @@ const (
 
 // FieldValidationMimeType model
 type FieldValidationMimeType struct {
-	MimeTypes []string `json:"linkMimetypeGroup,omitempty"`
+	MimeTypes    []string `json:"linkMimetypeGroup"`
+	ErrorMessage string   `json:"message,omitempty"`
+
+	validationExtra
+}
+
+func (v FieldValidationMimeType) validationName() string { return "linkMimetypeGroup" }
+
+// MarshalJSON for custom json marshaling
+func (v FieldValidationMimeType) MarshalJSON() ([]byte, error) {
+	type validation FieldValidationMimeType
+	return marshalValidation(validation(v), v.extra)
 }
 
-// MinMax model
+// MinMax model. A bound is set when it is not zero, or when it was present in
+// the json it was decoded from, so that a zero bound read from the api is
+// saved back.
 type MinMax struct {
 	Min float64 `json:"min,omitempty"`
 	Max float64 `json:"max,omitempty"`
+
+	hasMin, hasMax bool
+}
+
+// HasMin reports whether the minimum is set
+func (m MinMax) HasMin() bool {
+	return m.Min != 0 || m.hasMin
+}
+
+// HasMax reports whether the maximum is set
+func (m MinMax) HasMax() bool {
+	return m.Max != 0 || m.hasMax
+}
+
+// MarshalJSON for custom json marshaling
+func (m MinMax) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&struct {
+		Min *float64 `json:"min,omitempty"`
+		Max *float64 `json:"max,omitempty"`
+	}{
+		Min: optionalBound(m.Min, m.HasMin()),
+		Max: optionalBound(m.Max, m.HasMax()),
+	})
+}
+
+// UnmarshalJSON for custom json unmarshaling
+func (m *MinMax) UnmarshalJSON(data []byte) error {
+	var payload struct {
+		Min *float64 `json:"min"`
+		Max *float64 `json:"max"`
+	}
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return err
+	}
+
+	*m = MinMax{}
+	if payload.Min != nil {
+		m.Min, m.hasMin = *payload.Min, true
+	}
+
+	if payload.Max != nil {
+		m.Max, m.hasMax = *payload.Max, true
+	}
+
+	return nil
+}
+
+func optionalBound(value float64, set bool) *float64 {
+	if !set {
+		return nil
+	}
+
+	return &value
 }
 
 // DateMinMax model
 type DateMinMax struct {
 	Min time.Time `json:"min,omitempty"`
 	Max time.Time `json:"max,omitempty"`
+
+	// the bounds as read from the api, saved back while unchanged
+	rawMin string
+	rawMax string
 }
 
 // FieldValidationDimension model
@@ -73,16 +315,20 @@ type FieldValidationDimension struct {
 	Width        *MinMax `json:"width,omitempty"`
 	Height       *MinMax `json:"height,omitempty"`
 	ErrorMessage string  `json:"message,omitempty"`
+
+	validationExtra
 }
 
-// MarshalJSON for custom json marshaling
-func (v *FieldValidationDimension) MarshalJSON() ([]byte, error) {
-	type dimension struct {
-		Width  *MinMax `json:"width,omitempty"`
-		Height *MinMax `json:"height,omitempty"`
-	}
+func (v FieldValidationDimension) validationName() string { return "assetImageDimensions" }
 
-	return json.Marshal(&struct {
+type dimension struct {
+	Width  *MinMax `json:"width,omitempty"`
+	Height *MinMax `json:"height,omitempty"`
+}
+
+// MarshalJSON for custom json marshaling
+func (v FieldValidationDimension) MarshalJSON() ([]byte, error) {
+	return marshalValidation(&struct {
 		AssetImageDimensions *dimension `json:"assetImageDimensions,omitempty"`
 		Message              string     `json:"message,omitempty"`
 	}{
@@ -91,44 +337,23 @@ func (v *FieldValidationDimension) MarshalJSON() ([]byte, error) {
 			Height: v.Height,
 		},
 		Message: v.ErrorMessage,
-	})
+	}, v.extra)
 }
 
 // UnmarshalJSON for custom json unmarshaling
 func (v *FieldValidationDimension) UnmarshalJSON(data []byte) error {
-	payload := map[string]interface{}{}
+	var payload struct {
+		AssetImageDimensions *dimension `json:"assetImageDimensions"`
+		Message              string     `json:"message"`
+	}
 	if err := json.Unmarshal(data, &payload); err != nil {
 		return err
 	}
 
-	dimensionData := payload["assetImageDimensions"].(map[string]interface{})
-
-	if width, ok := dimensionData["width"].(map[string]interface{}); ok {
-		v.Width = &MinMax{}
-
-		if min, ok := width["min"].(float64); ok {
-			v.Width.Min = min
-		}
-
-		if max, ok := width["min"].(float64); ok {
-			v.Width.Max = max
-		}
-	}
-
-	if height, ok := dimensionData["height"].(map[string]interface{}); ok {
-		v.Height = &MinMax{}
-
-		if min, ok := height["min"].(float64); ok {
-			v.Height.Min = min
-		}
-
-		if max, ok := height["max"].(float64); ok {
-			v.Height.Max = max
-		}
-	}
-
-	if val, ok := payload["message"].(string); ok {
-		v.ErrorMessage = val
+	*v = FieldValidationDimension{ErrorMessage: payload.Message}
+	if payload.AssetImageDimensions != nil {
+		v.Width = payload.AssetImageDimensions.Width
+		v.Height = payload.AssetImageDimensions.Height
 	}
 
 	return nil
@@ -138,81 +363,152 @@ func (v *FieldValidationDimension) UnmarshalJSON(data []byte) error {
 type FieldValidationFileSize struct {
 	Size         *MinMax `json:"assetFileSize,omitempty"`
 	ErrorMessage string  `json:"message,omitempty"`
+
+	validationExtra
+}
+
+func (v FieldValidationFileSize) validationName() string { return "assetFileSize" }
+
+// MarshalJSON for custom json marshaling
+func (v FieldValidationFileSize) MarshalJSON() ([]byte, error) {
+	type validation FieldValidationFileSize
+	return marshalValidation(validation(v), v.extra)
 }
 
 // FieldValidationUnique model
 type FieldValidationUnique struct {
-	Unique bool `json:"unique"`
+	Unique       bool   `json:"unique"`
+	ErrorMessage string `json:"message,omitempty"`
+
+	validationExtra
+}
+
+func (v FieldValidationUnique) validationName() string { return "unique" }
+
+// MarshalJSON for custom json marshaling
+func (v FieldValidationUnique) MarshalJSON() ([]byte, error) {
+	type validation FieldValidationUnique
+	return marshalValidation(validation(v), v.extra)
 }
 
 // FieldValidationPredefinedValues model
 type FieldValidationPredefinedValues struct {
-	In           []interface{} `json:"in,omitempty"`
-	ErrorMessage string        `json:"message"`
+	In           []interface{} `json:"in"`
+	ErrorMessage string        `json:"message,omitempty"`
+
+	validationExtra
+}
+
+func (v FieldValidationPredefinedValues) validationName() string { return "in" }
+
+// MarshalJSON for custom json marshaling
+func (v FieldValidationPredefinedValues) MarshalJSON() ([]byte, error) {
+	type validation FieldValidationPredefinedValues
+	return marshalValidation(validation(v), v.extra)
 }
 
 // FieldValidationRange model
 type FieldValidationRange struct {
 	Range        *MinMax `json:"range,omitempty"`
 	ErrorMessage string  `json:"message,omitempty"`
+
+	validationExtra
+}
+
+func (v FieldValidationRange) validationName() string { return "range" }
+
+// MarshalJSON for custom json marshaling
+func (v FieldValidationRange) MarshalJSON() ([]byte, error) {
+	type validation FieldValidationRange
+	return marshalValidation(validation(v), v.extra)
 }
 
 // FieldValidationDate model
 type FieldValidationDate struct {
 	Range        *DateMinMax `json:"dateRange,omitempty"`
 	ErrorMessage string      `json:"message,omitempty"`
+
+	validationExtra
 }
 
+func (v FieldValidationDate) validationName() string { return "dateRange" }
+
+// dateRangeLayout is the layout of the bounds of a date range
+const dateRangeLayout = "2006-01-02T15:04:05"
+
 // MarshalJSON for custom json marshaling
-func (v *FieldValidationDate) MarshalJSON() ([]byte, error) {
+func (v FieldValidationDate) MarshalJSON() ([]byte, error) {
 	type dateRange struct {
 		Min string `json:"min,omitempty"`
 		Max string `json:"max,omitempty"`
 	}
 
-	return json.Marshal(&struct {
+	payload := &struct {
 		DateRange *dateRange `json:"dateRange,omitempty"`
 		Message   string     `json:"message,omitempty"`
 	}{
-		DateRange: &dateRange{
-			Min: v.Range.Max.Format("2006-01-02T03:04:05"),
-			Max: v.Range.Max.Format("2006-01-02T03:04:05"),
-		},
-		Message: v.ErrorMessage,
-	})
+		DateRange: &dateRange{},
+		Message:   v.ErrorMessage,
+	}
+
+	if v.Range != nil {
+		payload.DateRange.Min = formatDateBound(v.Range.Min, v.Range.rawMin)
+		payload.DateRange.Max = formatDateBound(v.Range.Max, v.Range.rawMax)
+	}
+
+	return marshalValidation(payload, v.extra)
 }
 
-// UnmarshalJSON for custom json unmarshaling
+// formatDateBound is the raw bound it was parsed from while the date is
+// unchanged, so that its time zone and precision are kept
+func formatDateBound(date time.Time, raw string) string {
+	if date.IsZero() {
+		return ""
+	}
+
+	if raw != "" {
+		if parsed, err := ParseDate(raw); err == nil && parsed.Equal(date) {
+			return raw
+		}
+	}
+
+	return date.Format(dateRangeLayout)
+}
+
+// UnmarshalJSON for custom json unmarshaling. The bounds may omit the
+// seconds, the time and the time zone, like the values of Date fields.
 func (v *FieldValidationDate) UnmarshalJSON(data []byte) error {
-	payload := map[string]interface{}{}
+	var payload struct {
+		DateRange struct {
+			Min string `json:"min"`
+			Max string `json:"max"`
+		} `json:"dateRange"`
+		Message string `json:"message"`
+	}
 	if err := json.Unmarshal(data, &payload); err != nil {
 		return err
 	}
 
-	dateRangeData := payload["dateRange"].(map[string]interface{})
-
-	v.Range = &DateMinMax{}
+	*v = FieldValidationDate{Range: &DateMinMax{}, ErrorMessage: payload.Message}
 
-	if min, ok := dateRangeData["min"].(string); ok {
-		minDate, err := time.Parse("2006-01-02T03:04:05", min)
+	if payload.DateRange.Min != "" {
+		minDate, err := ParseDate(payload.DateRange.Min)
 		if err != nil {
 			return err
 		}
 
 		v.Range.Min = minDate
+		v.Range.rawMin = payload.DateRange.Min
 	}
 
-	if max, ok := dateRangeData["max"].(string); ok {
-		maxDate, err := time.Parse("2006-01-02T03:04:05", max)
+	if payload.DateRange.Max != "" {
+		maxDate, err := ParseDate(payload.DateRange.Max)
 		if err != nil {
 			return err
 		}
 
 		v.Range.Max = maxDate
-	}
-
-	if val, ok := payload["message"].(string); ok {
-		v.ErrorMessage = val
+		v.Range.rawMax = payload.DateRange.Max
 	}
 
 	return nil
@@ -222,6 +518,16 @@ func (v *FieldValidationDate) UnmarshalJSON(data []byte) error {
 type FieldValidationSize struct {
 	Size         *MinMax `json:"size,omitempty"`
 	ErrorMessage string  `json:"message,omitempty"`
+
+	validationExtra
+}
+
+func (v FieldValidationSize) validationName() string { return "size" }
+
+// MarshalJSON for custom json marshaling
+func (v FieldValidationSize) MarshalJSON() ([]byte, error) {
+	type validation FieldValidationSize
+	return marshalValidation(validation(v), v.extra)
 }
 
 // noinspection GoUnusedConst
@@ -261,4 +567,107 @@ type Regex struct {
 type FieldValidationRegex struct {
 	Regex        *Regex `json:"regexp,omitempty"`
 	ErrorMessage string `json:"message,omitempty"`
+
+	validationExtra
+}
+
+func (v FieldValidationRegex) validationName() string { return "regexp" }
+
+// MarshalJSON for custom json marshaling
+func (v FieldValidationRegex) MarshalJSON() ([]byte, error) {
+	type validation FieldValidationRegex
+	return marshalValidation(validation(v), v.extra)
+}
+
+// FieldValidationProhibitRegex model, the values of the field must not match the pattern
+type FieldValidationProhibitRegex struct {
+	Regex        *Regex `json:"prohibitRegexp,omitempty"`
+	ErrorMessage string `json:"message,omitempty"`
+
+	validationExtra
+}
+
+func (v FieldValidationProhibitRegex) validationName() string { return "prohibitRegexp" }
+
+// MarshalJSON for custom json marshaling
+func (v FieldValidationProhibitRegex) MarshalJSON() ([]byte, error) {
+	type validation FieldValidationProhibitRegex
+	return marshalValidation(validation(v), v.extra)
+}
+
+// FieldValidationEnabledNodeTypes model, the node types allowed in a RichText field
+type FieldValidationEnabledNodeTypes struct {
+	NodeTypes    []string `json:"enabledNodeTypes"`
+	ErrorMessage string   `json:"message,omitempty"`
+
+	validationExtra
+}
+
+func (v FieldValidationEnabledNodeTypes) validationName() string { return "enabledNodeTypes" }
+
+// MarshalJSON for custom json marshaling
+func (v FieldValidationEnabledNodeTypes) MarshalJSON() ([]byte, error) {
+	type validation FieldValidationEnabledNodeTypes
+	return marshalValidation(validation(v), v.extra)
+}
+
+// FieldValidationEnabledMarks model, the marks allowed in a RichText field
+type FieldValidationEnabledMarks struct {
+	Marks        []string `json:"enabledMarks"`
+	ErrorMessage string   `json:"message,omitempty"`
+
+	validationExtra
+}
+
+func (v FieldValidationEnabledMarks) validationName() string { return "enabledMarks" }
+
+// MarshalJSON for custom json marshaling
+func (v FieldValidationEnabledMarks) MarshalJSON() ([]byte, error) {
+	type validation FieldValidationEnabledMarks
+	return marshalValidation(validation(v), v.extra)
+}
+
+// FieldValidationNodes model, the validations of the nodes of a RichText
+// field by node type, eg. the content types of "embedded-entry-block" nodes
+type FieldValidationNodes struct {
+	Nodes        map[string][]FieldValidation `json:"nodes"`
+	ErrorMessage string                       `json:"message,omitempty"`
+
+	validationExtra
+}
+
+func (v FieldValidationNodes) validationName() string { return "nodes" }
+
+// MarshalJSON for custom json marshaling
+func (v FieldValidationNodes) MarshalJSON() ([]byte, error) {
+	type validation FieldValidationNodes
+	return marshalValidation(validation(v), v.extra)
+}
+
+// UnmarshalJSON for custom json unmarshaling
+func (v *FieldValidationNodes) UnmarshalJSON(data []byte) error {
+	var payload struct {
+		Nodes   map[string][]interface{} `json:"nodes"`
+		Message string                   `json:"message"`
+	}
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return err
+	}
+
+	*v = FieldValidationNodes{Nodes: map[string][]FieldValidation{}, ErrorMessage: payload.Message}
+	for nodeType, data := range payload.Nodes {
+		validations, err := ParseValidations(data)
+		if err != nil {
+			return err
+		}
+
+		// an empty list of validations is kept
+		if validations == nil {
+			validations = []FieldValidation{}
+		}
+
+		v.Nodes[nodeType] = validations
+	}
+
+	return nil
 }
@@ -107,6 +107,25 @@ func TestFieldValidationRange(t *testing.T) {
 	assertions.Equal("error message", validationCheck.ErrorMessage)
 }
 
+func TestFieldValidationDate_RawBounds(t *testing.T) {
+	assertions := assert.New(t)
+
+	var validation FieldValidationDate
+	assertions.Nil(json.Unmarshal([]byte(`{"dateRange":{"min":"2017-03-15","max":"2017-03-31T15:00+02:00"}}`), &validation))
+	assertions.Equal(time.Date(2017, 3, 31, 13, 0, 0, 0, time.UTC), validation.Range.Max.UTC())
+
+	// unchanged bounds keep their time zone and precision
+	data, err := json.Marshal(validation)
+	assertions.Nil(err)
+	assertions.Equal(`{"dateRange":{"min":"2017-03-15","max":"2017-03-31T15:00+02:00"}}`, string(data))
+
+	// changed bounds are formatted
+	validation.Range.Min = time.Date(2017, 3, 16, 8, 30, 0, 0, time.UTC)
+	data, err = json.Marshal(validation)
+	assertions.Nil(err)
+	assertions.Equal(`{"dateRange":{"min":"2017-03-16T08:30:00","max":"2017-03-31T15:00+02:00"}}`, string(data))
+}
+
 func TestFieldValidationSize(t *testing.T) {
 	var err error
 	assertions := assert.New(t)
@@ -135,7 +154,7 @@ func TestFieldValidationDate(t *testing.T) {
 	var err error
 	assertions := assert.New(t)
 
-	layout := "2006-01-02T03:04:05"
+	layout := "2006-01-02T15:04:05"
 	min := time.Now()
 	max := time.Now()
 
@@ -160,3 +179,123 @@ func TestFieldValidationDate(t *testing.T) {
 	assertions.Equal(maxStr, validationCheck.Range.Max.Format(layout))
 	assertions.Equal("error message", validationCheck.ErrorMessage)
 }
+
+func TestFieldValidationDimension(t *testing.T) {
+	assertions := assert.New(t)
+
+	var validation FieldValidationDimension
+	err := json.Unmarshal([]byte(`{"assetImageDimensions":{"width":{"min":100,"max":200},"height":{"max":300}},"message":"error message"}`), &validation)
+	assertions.Nil(err)
+	assertions.Equal(float64(100), validation.Width.Min)
+	assertions.Equal(float64(200), validation.Width.Max)
+	assertions.False(validation.Height.HasMin())
+	assertions.Equal(float64(300), validation.Height.Max)
+	assertions.Equal("error message", validation.ErrorMessage)
+
+	// parsed validations are values, which are marshaled like pointers
+	data, err := json.Marshal(validation)
+	assertions.Nil(err)
+	assertions.Equal(`{"assetImageDimensions":{"width":{"min":100,"max":200},"height":{"max":300}},"message":"error message"}`, string(data))
+}
+
+func TestMinMax(t *testing.T) {
+	assertions := assert.New(t)
+
+	var bounds MinMax
+	assertions.Nil(json.Unmarshal([]byte(`{"min":0}`), &bounds))
+	assertions.True(bounds.HasMin())
+	assertions.False(bounds.HasMax())
+
+	data, err := json.Marshal(bounds)
+	assertions.Nil(err)
+	assertions.Equal(`{"min":0}`, string(data))
+
+	data, err = json.Marshal(MinMax{Max: 5})
+	assertions.Nil(err)
+	assertions.Equal(`{"max":5}`, string(data))
+}
+
+func TestParseValidations(t *testing.T) {
+	assertions := assert.New(t)
+
+	validations, err := ParseValidations([]interface{}{
+		map[string]interface{}{"prohibitRegexp": map[string]interface{}{"pattern": "lorem"}},
+		map[string]interface{}{"enabledMarks": []interface{}{"bold"}, "message": "Only bold"},
+		`{"unique":true}`,
+		map[string]interface{}{"newValidation": true, "message": "new"},
+	})
+	assertions.Nil(err)
+	assertions.Equal([]FieldValidation{
+		FieldValidationProhibitRegex{Regex: &Regex{Pattern: "lorem"}},
+		FieldValidationEnabledMarks{Marks: []string{"bold"}, ErrorMessage: "Only bold"},
+		FieldValidationUnique{Unique: true},
+		FieldValidationUnknown{Raw: json.RawMessage(`{"message":"new","newValidation":true}`)},
+	}, validations)
+	assertions.Equal("newValidation", validations[3].(FieldValidationUnknown).validationName())
+
+	// the name of an unknown validation is its first key other than the message
+	for i := 0; i < 10; i++ {
+		assertions.Equal("alpha", FieldValidationUnknown{Raw: json.RawMessage(`{"message":"m","zeta":1,"beta":2,"alpha":3}`)}.validationName())
+	}
+}
+
+func TestFieldValidations_RoundTrip(t *testing.T) {
+	assertions := assert.New(t)
+
+	var payload struct {
+		Fields []json.RawMessage `json:"fields"`
+	}
+	assertions.Nil(json.Unmarshal([]byte(readTestData("content_type_round_trip.json")), &payload))
+
+	var ct ContentType
+	assertions.Nil(json.Unmarshal([]byte(readTestData("content_type_round_trip.json")), &ct))
+
+	nodes := ct.Fields[1].Validations[2].(FieldValidationNodes)
+	assertions.Equal(FieldValidationLink{LinkContentType: []string{"image", "video"}, ErrorMessage: "Only images and videos"}, nodes.Nodes["embedded-entry-block"][0])
+	assertions.Equal(FieldValidationLink{LinkContentType: []string{"article"}, ErrorMessage: "Only articles"}, ct.Fields[3].Items.Validations[0])
+	assertions.IsType(FieldValidationUnknown{}, ct.Fields[5].Validations[0])
+
+	// a content type read from the api is saved unchanged
+	for i, field := range ct.Fields {
+		data, err := json.Marshal(field)
+		assertions.Nil(err)
+		assertions.JSONEq(string(payload.Fields[i]), string(data))
+	}
+}
+
+func TestFieldValidations_RoundTripKeys(t *testing.T) {
+	assertions := assert.New(t)
+
+	// empty lists and the keys a validation type does not have are saved back
+	for _, data := range []string{
+		`{"in":[]}`,
+		`{"linkContentType":[]}`,
+		`{"linkMimetypeGroup":[],"message":"No assets"}`,
+		`{"linkContentType":["a"],"extraKey":1}`,
+		`{"unique":true,"message":"","extraKey":{"nested":[1,2]}}`,
+		`{"assetImageDimensions":{"width":{"min":100}},"extraKey":"value"}`,
+		`{"dateRange":{"min":"2020-01-01"},"extraKey":true}`,
+		`{"nodes":{"embedded-entry-block":[{"linkContentType":["a"],"extraKey":1}]},"extraKey":null}`,
+	} {
+		validations, err := ParseValidations([]interface{}{data})
+		assertions.Nil(err)
+		_, unknown := validations[0].(FieldValidationUnknown)
+		assertions.False(unknown, data)
+
+		encoded, err := json.Marshal(validations[0])
+		assertions.Nil(err)
+		assertions.JSONEq(data, string(encoded))
+	}
+
+	// the keys of the type take precedence over the extra keys
+	validations, err := ParseValidations([]interface{}{`{"linkContentType":["a"],"message":"","extraKey":1}`})
+	assertions.Nil(err)
+
+	link := validations[0].(FieldValidationLink)
+	link.LinkContentType = append(link.LinkContentType, "b")
+	link.ErrorMessage = "Only a and b"
+
+	encoded, err := json.Marshal(link)
+	assertions.Nil(err)
+	assertions.JSONEq(`{"linkContentType":["a","b"],"message":"Only a and b","extraKey":1}`, string(encoded))
+}
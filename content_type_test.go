@@ -1,6 +1,7 @@
 package contentful
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -14,7 +15,7 @@ import (
 func ExampleContentTypesService_Get() {
 	cma := NewCMA("cma-token")
 
-	contentType, err := cma.ContentTypes.Get("space-id", "content-type-id")
+	contentType, err := cma.ContentTypes.Get(context.Background(), "space-id", "content-type-id")
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -25,7 +26,7 @@ func ExampleContentTypesService_Get() {
 func ExampleContentTypesService_List() {
 	cma := NewCMA("cma-token")
 
-	collection, err := cma.ContentTypes.List("space-id").Next()
+	collection, err := cma.ContentTypes.List("space-id").Next(context.Background())
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -42,7 +43,7 @@ func ExampleContentTypesService_Upsert_create() {
 
 	ct, _ := contentTypeFromTestData("content_type.json")
 
-	err := cma.ContentTypes.Upsert("space-id", ct)
+	err := cma.ContentTypes.Upsert(context.Background(), "space-id", ct)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -53,7 +54,7 @@ func ExampleContentTypesService_Upsert_create_with_environment() {
 	env, _ := environmentFromTestData("environment_1.json")
 	ct, _ := contentTypeFromTestData("content_type.json")
 
-	err := cma.ContentTypes.UpsertWithEnv(env, ct)
+	err := cma.ContentTypes.UpsertWithEnv(context.Background(), env, ct)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -62,14 +63,14 @@ func ExampleContentTypesService_Upsert_create_with_environment() {
 func ExampleContentTypesService_Upsert_update() {
 	cma := NewCMA("cma-token")
 
-	contentType, err := cma.ContentTypes.Get("space-id", "content-type-id")
+	contentType, err := cma.ContentTypes.Get(context.Background(), "space-id", "content-type-id")
 	if err != nil {
 		log.Fatal(err)
 	}
 
 	contentType.Name = "modified content type name"
 
-	err = cma.ContentTypes.Upsert("space-id", contentType)
+	err = cma.ContentTypes.Upsert(context.Background(), "space-id", contentType)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -79,14 +80,14 @@ func ExampleContentTypesService_Upsert_update_with_environment() {
 	cma := NewCMA("cma-token")
 	env, _ := environmentFromTestData("environment_1.json")
 
-	contentType, err := cma.ContentTypes.Get("space-id", "content-type-id")
+	contentType, err := cma.ContentTypes.Get(context.Background(), "space-id", "content-type-id")
 	if err != nil {
 		log.Fatal(err)
 	}
 
 	contentType.Name = "modified content type name"
 
-	err = cma.ContentTypes.UpsertWithEnv(env, contentType)
+	err = cma.ContentTypes.UpsertWithEnv(context.Background(), env, contentType)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -95,12 +96,12 @@ func ExampleContentTypesService_Upsert_update_with_environment() {
 func ExampleContentTypesService_Activate() {
 	cma := NewCMA("cma-token")
 
-	contentType, err := cma.ContentTypes.Get("space-id", "content-type-id")
+	contentType, err := cma.ContentTypes.Get(context.Background(), "space-id", "content-type-id")
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	err = cma.ContentTypes.Activate("space-id", contentType)
+	err = cma.ContentTypes.Activate(context.Background(), "space-id", contentType)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -111,12 +112,12 @@ func ExampleContentTypesService_Activate_with_environment() {
 
 	env, _ := environmentFromTestData("environment_1.json")
 
-	contentType, err := cma.ContentTypes.Get("space-id", "content-type-id")
+	contentType, err := cma.ContentTypes.Get(context.Background(), "space-id", "content-type-id")
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	err = cma.ContentTypes.ActivateWithEnv(env, contentType)
+	err = cma.ContentTypes.ActivateWithEnv(context.Background(), env, contentType)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -125,12 +126,12 @@ func ExampleContentTypesService_Activate_with_environment() {
 func ExampleContentTypesService_Deactivate() {
 	cma := NewCMA("cma-token")
 
-	contentType, err := cma.ContentTypes.Get("space-id", "content-type-id")
+	contentType, err := cma.ContentTypes.Get(context.Background(), "space-id", "content-type-id")
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	err = cma.ContentTypes.Deactivate("space-id", contentType)
+	err = cma.ContentTypes.Deactivate(context.Background(), "space-id", contentType)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -141,12 +142,12 @@ func ExampleContentTypesService_Deactivate_with_environment() {
 
 	env, _ := environmentFromTestData("environment_1.json")
 
-	contentType, err := cma.ContentTypes.Get("space-id", "content-type-id")
+	contentType, err := cma.ContentTypes.Get(context.Background(), "space-id", "content-type-id")
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	err = cma.ContentTypes.DeactivateWithEnv(env, contentType)
+	err = cma.ContentTypes.DeactivateWithEnv(context.Background(), env, contentType)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -155,12 +156,12 @@ func ExampleContentTypesService_Deactivate_with_environment() {
 func ExampleContentTypesService_Delete() {
 	cma := NewCMA("cma-token")
 
-	contentType, err := cma.ContentTypes.Get("space-id", "content-type-id")
+	contentType, err := cma.ContentTypes.Get(context.Background(), "space-id", "content-type-id")
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	err = cma.ContentTypes.Delete("space-id", contentType)
+	err = cma.ContentTypes.Delete(context.Background(), "space-id", contentType)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -171,12 +172,12 @@ func ExampleContentTypesService_Delete_with_environment() {
 
 	env, _ := environmentFromTestData("environment_1.json")
 
-	contentType, err := cma.ContentTypes.GetWithEnv(env, "content-type-id")
+	contentType, err := cma.ContentTypes.GetWithEnv(context.Background(), env, "content-type-id")
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	err = cma.ContentTypes.DeleteWithEnv(env, contentType)
+	err = cma.ContentTypes.DeleteWithEnv(context.Background(), env, contentType)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -185,7 +186,7 @@ func ExampleContentTypesService_Delete_with_environment() {
 func ExampleContentTypesService_Delete_all_drafts() {
 	cma := NewCMA("cma-token")
 
-	collection, err := cma.ContentTypes.List("space-id").Next()
+	collection, err := cma.ContentTypes.List("space-id").Next(context.Background())
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -194,7 +195,7 @@ func ExampleContentTypesService_Delete_all_drafts() {
 
 	for _, contentType := range contentTypes {
 		if contentType.Sys.PublishedAt == "" {
-			err := cma.ContentTypes.Delete("space-id", contentType)
+			err := cma.ContentTypes.Delete(context.Background(), "space-id", contentType)
 			if err != nil {
 				log.Fatal(err)
 			}
@@ -208,7 +209,7 @@ func TestContentTypesService_List(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "GET")
-		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/content_types")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/master/content_types")
 
 		checkHeaders(r, assertions)
 
@@ -222,9 +223,9 @@ func TestContentTypesService_List(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	collection, err := cma.ContentTypes.List(spaceID).Next()
+	collection, err := cma.ContentTypes.List(spaceID).Next(context.Background())
 	assertions.Nil(err)
 	contentType := collection.ToContentType()
 	assertions.Equal(4, len(contentType))
@@ -237,7 +238,7 @@ func TestContentTypesService_ListActivated(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "GET")
-		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/public/content_types")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/master/public/content_types")
 
 		checkHeaders(r, assertions)
 
@@ -251,9 +252,9 @@ func TestContentTypesService_ListActivated(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	_, err = cma.ContentTypes.ListActivated(spaceID).Next()
+	_, err = cma.ContentTypes.ListActivated(spaceID).Next(context.Background())
 	assertions.Nil(err)
 }
 
@@ -263,7 +264,7 @@ func TestContentTypesService_Get(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "GET")
-		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/content_types/63Vgs0BFK0USe4i2mQUGK6")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/master/content_types/63Vgs0BFK0USe4i2mQUGK6")
 
 		checkHeaders(r, assertions)
 
@@ -277,9 +278,9 @@ func TestContentTypesService_Get(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	contentType, err := cma.ContentTypes.Get(spaceID, "63Vgs0BFK0USe4i2mQUGK6")
+	contentType, err := cma.ContentTypes.Get(context.Background(), spaceID, "63Vgs0BFK0USe4i2mQUGK6")
 	assertions.Nil(err)
 	assertions.Equal("63Vgs0BFK0USe4i2mQUGK6", contentType.Sys.ID)
 }
@@ -307,13 +308,13 @@ func TestContentTypesService_GetFromEnv(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	// test content type
 	ct, err := contentTypeFromTestData("content_type.json")
 	assertions.Nil(err)
 
-	contentType, err := cma.ContentTypes.GetWithEnv(env, ct.Sys.ID)
+	contentType, err := cma.ContentTypes.GetWithEnv(context.Background(), env, ct.Sys.ID)
 	assertions.Nil(err)
 	assertions.Equal("63Vgs0BFK0USe4i2mQUGK6", contentType.Sys.ID)
 }
@@ -324,7 +325,7 @@ func TestContentTypesService_Get_2(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "GET")
-		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/content_types/63Vgs0BFK0USe4i2mQUGK6")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/master/content_types/63Vgs0BFK0USe4i2mQUGK6")
 
 		checkHeaders(r, assertions)
 
@@ -338,9 +339,9 @@ func TestContentTypesService_Get_2(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	_, err = cma.ContentTypes.Get(spaceID, "63Vgs0BFK0USe4i2mQUGK6")
+	_, err = cma.ContentTypes.Get(context.Background(), spaceID, "63Vgs0BFK0USe4i2mQUGK6")
 	assertions.NotNil(err)
 }
 
@@ -350,7 +351,7 @@ func TestContentTypesService_Activate(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "PUT")
-		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/content_types/63Vgs0BFK0USe4i2mQUGK6/published")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/master/content_types/63Vgs0BFK0USe4i2mQUGK6/published")
 
 		checkHeaders(r, assertions)
 
@@ -364,13 +365,13 @@ func TestContentTypesService_Activate(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	// test content type
 	ct, err := contentTypeFromTestData("content_type.json")
 	assertions.Nil(err)
 
-	err = cma.ContentTypes.Activate(spaceID, ct)
+	err = cma.ContentTypes.Activate(context.Background(), spaceID, ct)
 	assertions.Nil(err)
 }
 
@@ -397,13 +398,13 @@ func TestContentTypesService_Activate_with_env(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	// test content type
 	ct, err := contentTypeFromTestData("content_type.json")
 	assertions.Nil(err)
 
-	err = cma.ContentTypes.ActivateWithEnv(env, ct)
+	err = cma.ContentTypes.ActivateWithEnv(context.Background(), env, ct)
 	assertions.Nil(err)
 }
 
@@ -413,7 +414,7 @@ func TestContentTypesService_Deactivate(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "DELETE")
-		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/content_types/63Vgs0BFK0USe4i2mQUGK6/published")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/master/content_types/63Vgs0BFK0USe4i2mQUGK6/published")
 
 		checkHeaders(r, assertions)
 
@@ -427,13 +428,13 @@ func TestContentTypesService_Deactivate(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	// test content type
 	ct, err := contentTypeFromTestData("content_type.json")
 	assertions.Nil(err)
 
-	err = cma.ContentTypes.Deactivate(spaceID, ct)
+	err = cma.ContentTypes.Deactivate(context.Background(), spaceID, ct)
 	assertions.Nil(err)
 }
 
@@ -460,13 +461,13 @@ func TestContentTypesService_Deactivate_with_env(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	// test content type
 	ct, err := contentTypeFromTestData("content_type.json")
 	assertions.Nil(err)
 
-	err = cma.ContentTypes.DeactivateWithEnv(env, ct)
+	err = cma.ContentTypes.DeactivateWithEnv(context.Background(), env, ct)
 	assertions.Nil(err)
 }
 
@@ -476,7 +477,7 @@ func TestContentTypeSaveForCreate(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "PUT")
-		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/content_types/ct-name")
+		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/environments/master/content_types/ct-name")
 		checkHeaders(r, assertions)
 
 		var payload map[string]interface{}
@@ -512,7 +513,7 @@ func TestContentTypeSaveForCreate(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	field1 := &Field{
 		ID:       "field1",
@@ -535,7 +536,7 @@ func TestContentTypeSaveForCreate(t *testing.T) {
 		DisplayField: field1.ID,
 	}
 
-	err = cma.ContentTypes.Upsert("id1", ct)
+	err = cma.ContentTypes.Upsert(context.Background(), "id1", ct)
 	assertions.Nil(err)
 	assertions.Equal("63Vgs0BFK0USe4i2mQUGK6", ct.Sys.ID)
 	assertions.Equal("ct-name", ct.Name)
@@ -548,7 +549,7 @@ func TestContentTypeSaveForUpdate(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "PUT")
-		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/content_types/63Vgs0BFK0USe4i2mQUGK6")
+		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/environments/master/content_types/63Vgs0BFK0USe4i2mQUGK6")
 		checkHeaders(r, assertions)
 
 		var payload map[string]interface{}
@@ -589,7 +590,7 @@ func TestContentTypeSaveForUpdate(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	// test content type
 	ct, err := contentTypeFromTestData("content_type.json")
@@ -617,7 +618,7 @@ func TestContentTypeSaveForUpdate(t *testing.T) {
 	ct.Fields = append(ct.Fields, field3)
 	ct.DisplayField = ct.Fields[2].ID
 
-	_ = cma.ContentTypes.Upsert("id1", ct)
+	_ = cma.ContentTypes.Upsert(context.Background(), "id1", ct)
 	assertions.Nil(err)
 	assertions.Equal("63Vgs0BFK0USe4i2mQUGK6", ct.Sys.ID)
 	assertions.Equal("ct-name-updated", ct.Name)
@@ -631,7 +632,7 @@ func TestContentTypeCreateWithID(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "PUT")
-		assertions.Equal(r.RequestURI, "/spaces/id1/content_types/mycontenttype")
+		assertions.Equal(r.RequestURI, "/spaces/id1/environments/master/content_types/mycontenttype")
 		checkHeaders(r, assertions)
 
 		w.WriteHeader(200)
@@ -644,7 +645,7 @@ func TestContentTypeCreateWithID(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	// test content type
 	ct := &ContentType{
@@ -654,7 +655,7 @@ func TestContentTypeCreateWithID(t *testing.T) {
 		Name: "MyContentType",
 	}
 
-	_ = cma.ContentTypes.Upsert("id1", ct)
+	_ = cma.ContentTypes.Upsert(context.Background(), "id1", ct)
 	assertions.Nil(err)
 }
 
@@ -664,7 +665,7 @@ func TestContentTypeDelete(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "DELETE")
-		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/content_types/63Vgs0BFK0USe4i2mQUGK6")
+		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/environments/master/content_types/63Vgs0BFK0USe4i2mQUGK6")
 		checkHeaders(r, assertions)
 
 		w.WriteHeader(200)
@@ -676,14 +677,14 @@ func TestContentTypeDelete(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	// test content type
 	ct, err := contentTypeFromTestData("content_type.json")
 	assertions.Nil(err)
 
 	// delete content type
-	err = cma.ContentTypes.Delete("id1", ct)
+	err = cma.ContentTypes.Delete(context.Background(), "id1", ct)
 	assertions.Nil(err)
 }
 
@@ -710,13 +711,13 @@ func TestContentTypesServiceDeleteFromEnv(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	// test content type
 	ct, err := contentTypeFromTestData("content_type.json")
 	assertions.Nil(err)
 
-	err = cma.ContentTypes.DeleteWithEnv(env, ct)
+	err = cma.ContentTypes.DeleteWithEnv(context.Background(), env, ct)
 	assertions.Nil(err)
 }
 
@@ -726,7 +727,7 @@ func TestContentTypeFieldRef(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "PUT")
-		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/content_types/ct-name")
+		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/environments/master/content_types/ct-name")
 		checkHeaders(r, assertions)
 
 		var payload map[string]interface{}
@@ -755,7 +756,7 @@ func TestContentTypeFieldRef(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	// test content type
 	linkCt, err := contentTypeFromTestData("content_type.json")
@@ -779,7 +780,7 @@ func TestContentTypeFieldRef(t *testing.T) {
 		DisplayField: field1.ID,
 	}
 
-	err = cma.ContentTypes.Upsert("id1", ct)
+	err = cma.ContentTypes.Upsert(context.Background(), "id1", ct)
 	assertions.Nil(err)
 }
 
@@ -789,7 +790,7 @@ func TestContentTypeFieldArray(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "PUT")
-		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/content_types/ct-name")
+		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/environments/master/content_types/ct-name")
 		checkHeaders(r, assertions)
 
 		var payload map[string]interface{}
@@ -819,7 +820,7 @@ func TestContentTypeFieldArray(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	field1 := &Field{
 		ID:   "field1",
@@ -842,7 +843,7 @@ func TestContentTypeFieldArray(t *testing.T) {
 		DisplayField: field1.ID,
 	}
 
-	err = cma.ContentTypes.Upsert("id1", ct)
+	err = cma.ContentTypes.Upsert(context.Background(), "id1", ct)
 	assertions.Nil(err)
 }
 
@@ -852,7 +853,7 @@ func TestContentTypeFieldValidationRangeUniquePredefinedValues(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "PUT")
-		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/content_types/ct-name")
+		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/environments/master/content_types/ct-name")
 		checkHeaders(r, assertions)
 
 		var payload map[string]interface{}
@@ -898,7 +899,7 @@ func TestContentTypeFieldValidationRangeUniquePredefinedValues(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	field1 := &Field{
 		ID:   "field1",
@@ -929,7 +930,7 @@ func TestContentTypeFieldValidationRangeUniquePredefinedValues(t *testing.T) {
 		DisplayField: field1.ID,
 	}
 
-	err = cma.ContentTypes.Upsert("id1", ct)
+	err = cma.ContentTypes.Upsert(context.Background(), "id1", ct)
 	assertions.Nil(err)
 }
 
@@ -939,7 +940,7 @@ func TestContentTypeFieldTypeMedia(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "PUT")
-		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/content_types/ct-name")
+		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/environments/master/content_types/ct-name")
 		checkHeaders(r, assertions)
 
 		var payload map[string]interface{}
@@ -1015,7 +1016,7 @@ func TestContentTypeFieldTypeMedia(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	field1 := &Field{
 		ID:       "field-id",
@@ -1064,7 +1065,7 @@ func TestContentTypeFieldTypeMedia(t *testing.T) {
 		DisplayField: field1.ID,
 	}
 
-	err = cma.ContentTypes.Upsert("id1", ct)
+	err = cma.ContentTypes.Upsert(context.Background(), "id1", ct)
 	assertions.Nil(err)
 }
 
@@ -1081,9 +1082,9 @@ func TestContentTypeFieldValidationsUnmarshal(t *testing.T) {
 	defer server.Close()
 
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	ct, err := cma.ContentTypes.Get(spaceID, "validationsTest")
+	ct, err := cma.ContentTypes.Get(context.Background(), spaceID, "validationsTest")
 	assertions.Nil(err)
 
 	var uniqueValidations []FieldValidation
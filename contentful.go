@@ -1,6 +1,7 @@
 package contentful
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -8,22 +9,32 @@ import (
 	"net/http"
 	"net/http/httputil"
 	"net/url"
-	"strconv"
-	"time"
+	"sync"
 
 	"moul.io/http2curl"
 )
 
 // Client model
+//
+// A Client is safe for concurrent use by multiple goroutines. It is
+// configured with options at construction, e.g. NewCMA(token,
+// WithBaseURL(url)), and its configuration is read with accessors such as
+// BaseURL and Environment; the Set methods remain safe to call once it is
+// shared. To work on several spaces or environments at once, use Space and
+// SpaceScope.Environment instead of SetEnvironment.
 type Client struct {
+	mu            *sync.RWMutex
 	client        *http.Client
 	api           string
 	token         string
-	Debug         bool
-	QueryParams   map[string]string
-	Headers       map[string]string
-	BaseURL       string
-	Environment   string
+	debug         bool
+	queryParams   map[string]string
+	headers       map[string]string
+	baseURL       string
+	environmentID string
+	retryPolicy   *RetryPolicy
+	rateLimiter   *RateLimiter
+	parent        *Client
 	commonService service
 
 	Spaces             *SpacesService
@@ -50,6 +61,9 @@ type Client struct {
 	AppInstallations   *AppInstallationsService
 	Usages             *UsagesService
 	Resources          *ResourcesService
+	Sync               *SyncService
+	BulkActions        *BulkActionsService
+	Releases           *ReleasesService
 }
 
 type service struct {
@@ -57,19 +71,20 @@ type service struct {
 }
 
 // NewCMA returns a CMA client
-func NewCMA(token string) *Client {
+func NewCMA(token string, options ...Option) *Client {
 	c := &Client{
+		mu:     &sync.RWMutex{},
 		client: http.DefaultClient,
 		api:    "CMA",
 		token:  token,
-		Debug:  false,
-		Headers: map[string]string{
+		headers: map[string]string{
 			"Authorization":           fmt.Sprintf("Bearer %s", token),
 			"Content-Type":            "application/vnd.contentful.management.v1+json",
 			"X-Contentful-User-Agent": fmt.Sprintf("sdk contentful.go/%s", Version),
 		},
-		BaseURL:     "https://api.contentful.com",
-		Environment: "master",
+		baseURL:       "https://api.contentful.com",
+		environmentID: "master",
+		rateLimiter:   NewRateLimiter(DefaultCMARateLimit),
 	}
 	c.commonService.c = c
 
@@ -96,23 +111,27 @@ func NewCMA(token string) *Client {
 	c.AppDefinitions = (*AppDefinitionsService)(&c.commonService)
 	c.AppInstallations = (*AppInstallationsService)(&c.commonService)
 	c.Usages = (*UsagesService)(&c.commonService)
-	return c
+	c.BulkActions = (*BulkActionsService)(&c.commonService)
+	c.Releases = (*ReleasesService)(&c.commonService)
+
+	return c.apply(options)
 }
 
 // NewCDA returns a CDA client
-func NewCDA(token string) *Client {
+func NewCDA(token string, options ...Option) *Client {
 	c := &Client{
+		mu:     &sync.RWMutex{},
 		client: http.DefaultClient,
 		api:    "CDA",
 		token:  token,
-		Debug:  false,
-		Headers: map[string]string{
+		headers: map[string]string{
 			"Authorization":           "Bearer " + token,
 			"Content-Type":            "application/vnd.contentful.delivery.v1+json",
 			"X-Contentful-User-Agent": fmt.Sprintf("contentful-go/%s", Version),
 		},
-		BaseURL:     "https://cdn.contentful.com",
-		Environment: "master",
+		baseURL:       "https://cdn.contentful.com",
+		environmentID: "master",
+		rateLimiter:   NewRateLimiter(DefaultCDARateLimit),
 	}
 	c.commonService.c = c
 
@@ -123,21 +142,24 @@ func NewCDA(token string) *Client {
 	c.Entries = (*EntriesService)(&c.commonService)
 	c.Locales = (*LocalesService)(&c.commonService)
 	c.Webhooks = (*WebhooksService)(&c.commonService)
+	c.Sync = (*SyncService)(&c.commonService)
 
-	return c
+	return c.apply(options)
 }
 
 // NewCPA returns a CPA client
-func NewCPA(token string) *Client {
+func NewCPA(token string, options ...Option) *Client {
 	c := &Client{
+		mu:     &sync.RWMutex{},
 		client: http.DefaultClient,
-		Debug:  false,
 		api:    "CPA",
 		token:  token,
-		Headers: map[string]string{
+		headers: map[string]string{
 			"Authorization": "Bearer " + token,
 		},
-		BaseURL: "https://preview.contentful.com",
+		baseURL:       "https://preview.contentful.com",
+		environmentID: "master",
+		rateLimiter:   NewRateLimiter(DefaultCPARateLimit),
 	}
 
 	c.Spaces = &SpacesService{c: c}
@@ -147,32 +169,37 @@ func NewCPA(token string) *Client {
 	c.Entries = &EntriesService{c: c}
 	c.Locales = &LocalesService{c: c}
 	c.Webhooks = &WebhooksService{c: c}
+	c.Sync = &SyncService{c: c}
 
-	return c
+	return c.apply(options)
 }
 
 // NewResourceClient returns a client for the resource/uploads endpoints
-func NewResourceClient(token string) *Client {
+func NewResourceClient(token string, options ...Option) *Client {
 	c := &Client{
+		mu:     &sync.RWMutex{},
 		client: http.DefaultClient,
 		api:    "URC",
-		Debug:  false,
 		token:  token,
-		Headers: map[string]string{
+		headers: map[string]string{
 			"Authorization": "Bearer " + token,
 		},
-		BaseURL: "https://upload.contentful.com",
+		baseURL:     "https://upload.contentful.com",
+		rateLimiter: NewRateLimiter(DefaultCMARateLimit),
 	}
 	c.commonService.c = c
 
 	c.Resources = (*ResourcesService)(&c.commonService)
 
-	return c
+	return c.apply(options)
 }
 
 // SetOrganization sets the given organization id
 func (c *Client) SetOrganization(organizationID string) *Client {
-	c.Headers["X-Contentful-Organization"] = organizationID
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.headers = withHeader(c.headers, "X-Contentful-Organization", organizationID)
 
 	return c
 }
@@ -180,36 +207,280 @@ func (c *Client) SetOrganization(organizationID string) *Client {
 // SetEnvironment sets the given environment.
 // https://www.contentful.com/developers/docs/references/content-management-api/#/reference/environments
 func (c *Client) SetEnvironment(environment string) *Client {
-	c.Environment = environment
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.environmentID = environment
+	return c
+}
+
+// environmentKey is the context key of the environment of a call
+type environmentKey struct{}
+
+// WithEnvironment returns a copy of ctx which overrides the environment of the
+// client for the calls made with it. Collections returned by List apply the
+// override of the context their pages are requested with, e.g. by Next or All.
+// The services of an EnvironmentScope keep the environment of the scope.
+func WithEnvironment(ctx context.Context, environment string) context.Context {
+	return context.WithValue(ctx, environmentKey{}, environment)
+}
+
+// environment returns the environment of a call. The environment of a copy
+// bound to an environment wins over the one of ctx.
+func (c *Client) environment(ctx context.Context) string {
+	if c.parent != nil {
+		return c.environmentID
+	}
+
+	if environment, ok := ctx.Value(environmentKey{}).(string); ok && environment != "" {
+		return environment
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.environmentID
+}
+
+// withEnvironment returns a copy of the client bound to an environment. The
+// copy reads the settings of the client, so that later Set calls on the
+// client apply to it, and its services are bound to the copy.
+func (c *Client) withEnvironment(environment string) *Client {
+	parent := c
+	if c.parent != nil {
+		parent = c.parent
+	}
+
+	parent.mu.RLock()
+	clone := *parent
+	parent.mu.RUnlock()
+
+	clone.mu = &sync.RWMutex{}
+	clone.parent = parent
+	clone.environmentID = environment
+	clone.commonService = service{c: &clone}
+	clone.bindServices(&clone.commonService)
+
+	return &clone
+}
+
+// bindServices points the services of the client, set by its constructor, to
+// common, whose client is c
+func (c *Client) bindServices(common *service) {
+	if c.Spaces != nil {
+		c.Spaces = (*SpacesService)(common)
+	}
+	if c.Users != nil {
+		c.Users = (*UsersService)(common)
+	}
+	if c.Environments != nil {
+		c.Environments = (*EnvironmentsService)(common)
+	}
+	if c.EnvironmentAliases != nil {
+		c.EnvironmentAliases = (*EnvironmentAliasesService)(common)
+	}
+	if c.Organizations != nil {
+		c.Organizations = (*OrganizationsService)(common)
+	}
+	if c.Roles != nil {
+		c.Roles = (*RolesService)(common)
+	}
+	if c.Memberships != nil {
+		c.Memberships = (*MembershipsService)(common)
+	}
+	if c.Snapshots != nil {
+		c.Snapshots = (*SnapshotsService)(common)
+	}
+	if c.APIKeys != nil {
+		c.APIKeys = (*APIKeyService)(common)
+	}
+	if c.AccessTokens != nil {
+		c.AccessTokens = (*AccessTokensService)(common)
+	}
+	if c.Assets != nil {
+		c.Assets = (*AssetsService)(common)
+	}
+	if c.ContentTypes != nil {
+		c.ContentTypes = (*ContentTypesService)(common)
+	}
+	if c.Entries != nil {
+		c.Entries = (*EntriesService)(common)
+	}
+	if c.EntryTasks != nil {
+		c.EntryTasks = (*EntryTasksService)(common)
+	}
+	if c.ScheduledActions != nil {
+		c.ScheduledActions = (*ScheduledActionsService)(common)
+	}
+	if c.Locales != nil {
+		c.Locales = (*LocalesService)(common)
+	}
+	if c.Webhooks != nil {
+		c.Webhooks = (*WebhooksService)(common)
+	}
+	if c.WebhookCalls != nil {
+		c.WebhookCalls = (*WebhookCallsService)(common)
+	}
+	if c.EditorInterfaces != nil {
+		c.EditorInterfaces = (*EditorInterfacesService)(common)
+	}
+	if c.Extensions != nil {
+		c.Extensions = (*ExtensionsService)(common)
+	}
+	if c.AppDefinitions != nil {
+		c.AppDefinitions = (*AppDefinitionsService)(common)
+	}
+	if c.AppInstallations != nil {
+		c.AppInstallations = (*AppInstallationsService)(common)
+	}
+	if c.Usages != nil {
+		c.Usages = (*UsagesService)(common)
+	}
+	if c.Resources != nil {
+		c.Resources = (*ResourcesService)(common)
+	}
+	if c.Sync != nil {
+		c.Sync = (*SyncService)(common)
+	}
+	if c.BulkActions != nil {
+		c.BulkActions = (*BulkActionsService)(common)
+	}
+	if c.Releases != nil {
+		c.Releases = (*ReleasesService)(common)
+	}
+}
+
+// SetRetryPolicy sets the policy used to retry failed requests. When no policy
+// is set, DefaultRetryPolicy is used.
+func (c *Client) SetRetryPolicy(policy *RetryPolicy) *Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.retryPolicy = policy
+	return c
+}
+
+// SetRateLimiter sets the limiter used to stay under the api quotas. Clients
+// using the same access token should share one limiter, a nil limiter
+// disables client side rate limiting.
+func (c *Client) SetRateLimiter(limiter *RateLimiter) *Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.rateLimiter = limiter
 	return c
 }
 
 // SetHTTPClient sets the underlying http.Client used to make requests.
 func (c *Client) SetHTTPClient(client *http.Client) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
 	c.client = client
 }
 
-func (c *Client) newRequest(method, path string, query url.Values, body io.Reader) (*http.Request, error) {
-	u, err := url.Parse(c.BaseURL)
+// BaseURL returns the url of the api
+func (c *Client) BaseURL() string {
+	return c.settings().baseURL
+}
+
+// Environment returns the environment of the calls which do not override it
+// with WithEnvironment
+func (c *Client) Environment() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.environmentID
+}
+
+// Headers returns a copy of the headers sent with every request
+func (c *Client) Headers() map[string]string {
+	return copyHeaders(c.settings().headers)
+}
+
+// QueryParams returns a copy of the query params sent with every request
+func (c *Client) QueryParams() map[string]string {
+	return copyHeaders(c.settings().queryParams)
+}
+
+// Debug reports whether the requests are printed as curl commands
+func (c *Client) Debug() bool {
+	return c.settings().debug
+}
+
+// RetryPolicy returns the policy used to retry failed requests, nil when
+// DefaultRetryPolicy is used
+func (c *Client) RetryPolicy() *RetryPolicy {
+	return c.settings().retryPolicy
+}
+
+// RateLimiter returns the limiter used to stay under the api quotas, nil when
+// client side rate limiting is disabled
+func (c *Client) RateLimiter() *RateLimiter {
+	return c.settings().rateLimiter
+}
+
+// settings is a snapshot of the configuration of a client
+type settings struct {
+	client      *http.Client
+	debug       bool
+	queryParams map[string]string
+	headers     map[string]string
+	baseURL     string
+	retryPolicy *RetryPolicy
+	rateLimiter *RateLimiter
+}
+
+// settings returns a consistent snapshot of the configuration. The maps are
+// replaced rather than modified by the Set methods, so they can be read
+// without holding the lock.
+func (c *Client) settings() settings {
+	if c.parent != nil {
+		return c.parent.settings()
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return settings{
+		client:      c.client,
+		debug:       c.debug,
+		queryParams: c.queryParams,
+		headers:     c.headers,
+		baseURL:     c.baseURL,
+		retryPolicy: c.retryPolicy,
+		rateLimiter: c.rateLimiter,
+	}
+}
+
+func (c *Client) newRequest(ctx context.Context, method, path string, query url.Values, body io.Reader) (*http.Request, error) {
+	config := c.settings()
+
+	u, err := url.Parse(config.baseURL)
 	if err != nil {
 		return nil, err
 	}
 
-	// set query params
-	for key, value := range c.QueryParams {
-		query.Set(key, value)
+	// set query params, without modifying the query of the caller
+	values := url.Values{}
+	for key, value := range query {
+		values[key] = value
+	}
+
+	for key, value := range config.queryParams {
+		values.Set(key, value)
 	}
 
 	u.Path = path
-	u.RawQuery = query.Encode()
+	u.RawQuery = values.Encode()
 
-	req, err := http.NewRequest(method, u.String(), body)
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
 	// set headers
-	for key, value := range c.Headers {
+	for key, value := range config.headers {
 		req.Header.Set(key, value)
 	}
 
@@ -217,61 +488,85 @@ func (c *Client) newRequest(method, path string, query url.Values, body io.Reade
 }
 
 func (c *Client) do(req *http.Request, v interface{}) error {
-	if c.Debug == true {
-		command, _ := http2curl.GetCurlCommand(req)
-		fmt.Println(command)
-	}
+	config := c.settings()
 
-	res, err := c.client.Do(req)
-	if err != nil {
-		return err
+	policy := config.retryPolicy
+	if policy == nil {
+		policy = DefaultRetryPolicy()
 	}
 
-	if res.StatusCode >= 200 && res.StatusCode < 400 {
-		// Upload/Create Resource response cannot be decoded
-		if c.api == "URC" && req.Method == "POST" {
-			defer res.Body.Close()
-		} else {
-			if v != nil {
-				defer res.Body.Close()
-				err = json.NewDecoder(res.Body).Decode(v)
-				if err != nil {
-					return err
-				}
+	for attempt := 1; ; attempt++ {
+		// retried requests have to resend the original payload
+		if attempt > 1 {
+			if err := rewindBody(req); err != nil {
+				return err
 			}
 		}
 
-		return nil
-	}
+		if config.debug == true {
+			command, _ := http2curl.GetCurlCommand(req)
+			fmt.Println(command)
+		}
+
+		if config.rateLimiter != nil {
+			if err := config.rateLimiter.Wait(req.Context()); err != nil {
+				return err
+			}
+		}
 
-	// parse api response
-	apiError := c.handleError(req, res)
+		res, err := config.client.Do(req)
+		if err != nil {
+			if attempt >= policy.MaxAttempts || !policy.retryableError(req.Method, err) || !rewindable(req) {
+				return err
+			}
 
-	// return apiError if it is not rate limit error
-	if _, ok := apiError.(RateLimitExceededError); !ok {
-		return apiError
-	}
+			if err := sleep(req.Context(), policy.delay(attempt, nil)); err != nil {
+				return err
+			}
+
+			continue
+		}
 
-	resetHeader := res.Header.Get("x-contentful-ratelimit-reset")
+		if config.rateLimiter != nil {
+			config.rateLimiter.Update(res.Header)
+		}
+
+		if res.StatusCode >= 200 && res.StatusCode < 400 {
+			return c.decode(req, res, v)
+		}
+
+		// parse api response
+		apiError := c.handleError(req, res)
 
-	// return apiError if Ratelimit-Reset header is not presented
-	if resetHeader == "" {
-		return apiError
+		// return apiError if it can not be retried, or its payload not resent
+		if attempt >= policy.MaxAttempts || !policy.retryableStatus(req.Method, res.StatusCode) || !rewindable(req) {
+			return apiError
+		}
+
+		// the wait is aborted as soon as the request context is done
+		if err := sleep(req.Context(), policy.delay(attempt, res)); err != nil {
+			return err
+		}
 	}
+}
 
-	// wait X-Contentful-Ratelimit-Reset amount of seconds
-	waitSeconds, err := strconv.Atoi(resetHeader)
-	if err != nil {
-		return apiError
+func (c *Client) decode(req *http.Request, res *http.Response, v interface{}) error {
+	defer res.Body.Close()
+
+	// Upload/Create Resource response cannot be decoded
+	if c.api == "URC" && req.Method == "POST" {
+		return nil
 	}
 
-	time.Sleep(time.Second * time.Duration(waitSeconds))
+	if v == nil {
+		return nil
+	}
 
-	return c.do(req, v)
+	return json.NewDecoder(res.Body).Decode(v)
 }
 
 func (c *Client) handleError(req *http.Request, res *http.Response) error {
-	if c.Debug == true {
+	if c.settings().debug == true {
 		dump, err := httputil.DumpResponse(res, true)
 		if err != nil {
 			log.Fatal(err)
@@ -2,6 +2,7 @@ package contentful
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -309,7 +310,7 @@ func setup() {
 	server = httptest.NewServer(handler)
 
 	c = NewCMA(CMAToken)
-	c.BaseURL = server.URL
+	c.baseURL = server.URL
 }
 
 func teardown() {
@@ -322,12 +323,12 @@ func TestContentfulNewCMA(t *testing.T) {
 
 	cma := NewCMA(CMAToken)
 	assertions.IsType(Client{}, *cma)
-	assertions.Equal("https://api.contentful.com", cma.BaseURL)
+	assertions.Equal("https://api.contentful.com", cma.BaseURL())
 	assertions.Equal("CMA", cma.api)
 	assertions.Equal(CMAToken, cma.token)
-	assertions.Equal(fmt.Sprintf("Bearer %s", CMAToken), cma.Headers["Authorization"])
-	assertions.Equal("application/vnd.contentful.management.v1+json", cma.Headers["Content-Type"])
-	assertions.Equal(fmt.Sprintf("sdk contentful.go/%s", Version), cma.Headers["X-Contentful-User-Agent"])
+	assertions.Equal(fmt.Sprintf("Bearer %s", CMAToken), cma.Headers()["Authorization"])
+	assertions.Equal("application/vnd.contentful.management.v1+json", cma.Headers()["Content-Type"])
+	assertions.Equal(fmt.Sprintf("sdk contentful.go/%s", Version), cma.Headers()["X-Contentful-User-Agent"])
 }
 
 func TestContentfulNewCDA(t *testing.T) {
@@ -335,12 +336,12 @@ func TestContentfulNewCDA(t *testing.T) {
 
 	cda := NewCDA(CDAToken)
 	assertions.IsType(Client{}, *cda)
-	assertions.Equal("https://cdn.contentful.com", cda.BaseURL)
+	assertions.Equal("https://cdn.contentful.com", cda.BaseURL())
 	assertions.Equal("CDA", cda.api)
 	assertions.Equal(CDAToken, cda.token)
-	assertions.Equal(fmt.Sprintf("Bearer %s", CDAToken), cda.Headers["Authorization"])
-	assertions.Equal("application/vnd.contentful.delivery.v1+json", cda.Headers["Content-Type"])
-	assertions.Equal(fmt.Sprintf("contentful-go/%s", Version), cda.Headers["X-Contentful-User-Agent"])
+	assertions.Equal(fmt.Sprintf("Bearer %s", CDAToken), cda.Headers()["Authorization"])
+	assertions.Equal("application/vnd.contentful.delivery.v1+json", cda.Headers()["Content-Type"])
+	assertions.Equal(fmt.Sprintf("contentful-go/%s", Version), cda.Headers()["X-Contentful-User-Agent"])
 }
 
 func TestContentfulNewCPA(t *testing.T) {
@@ -348,9 +349,10 @@ func TestContentfulNewCPA(t *testing.T) {
 
 	cpa := NewCPA(CPAToken)
 	assertions.IsType(Client{}, *cpa)
-	assertions.Equal("https://preview.contentful.com", cpa.BaseURL)
+	assertions.Equal("https://preview.contentful.com", cpa.BaseURL())
 	assertions.Equal("CPA", cpa.api)
 	assertions.Equal(CPAToken, cpa.token)
+	assertions.Equal("master", cpa.Environment())
 }
 
 func TestNewResourceClient(t *testing.T) {
@@ -358,10 +360,10 @@ func TestNewResourceClient(t *testing.T) {
 
 	urc := NewResourceClient(CMAToken)
 	assertions.IsType(Client{}, *urc)
-	assertions.Equal("https://upload.contentful.com", urc.BaseURL)
+	assertions.Equal("https://upload.contentful.com", urc.BaseURL())
 	assertions.Equal("URC", urc.api)
 	assertions.Equal(CMAToken, urc.token)
-	assertions.Equal(fmt.Sprintf("Bearer %s", CMAToken), urc.Headers["Authorization"])
+	assertions.Equal(fmt.Sprintf("Bearer %s", CMAToken), urc.Headers()["Authorization"])
 }
 
 func TestContentfulSetOrganization(t *testing.T) {
@@ -369,7 +371,107 @@ func TestContentfulSetOrganization(t *testing.T) {
 
 	cma := NewCMA(CMAToken)
 	cma.SetOrganization(organizationID)
-	assertions.Equal(organizationID, cma.Headers["X-Contentful-Organization"])
+	assertions.Equal(organizationID, cma.Headers()["X-Contentful-Organization"])
+}
+
+func TestContentfulHeaders(t *testing.T) {
+	assertions := assert.New(t)
+
+	cma := NewCMA(CMAToken)
+	staging := cma.Space(spaceID).Environment("staging")
+
+	// the headers of a client are returned as a copy
+	headers := cma.Headers()
+	headers["Authorization"] = "Bearer other"
+	assertions.Equal(fmt.Sprintf("Bearer %s", CMAToken), cma.Headers()["Authorization"])
+
+	// the client of a scope reads the settings of the client, even the ones set later
+	cma.SetOrganization(organizationID)
+	assertions.Equal(organizationID, staging.client.Headers()["X-Contentful-Organization"])
+
+	limiter := NewRateLimiter(1)
+	policy := NoRetryPolicy()
+	cma.SetRateLimiter(limiter).SetRetryPolicy(policy)
+	assertions.Same(limiter, staging.client.RateLimiter())
+	assertions.Same(policy, staging.client.RetryPolicy())
+
+	// its environment wins over the one of the context
+	assertions.Equal("staging", staging.client.Environment())
+	assertions.Equal("staging", staging.client.environment(WithEnvironment(context.Background(), "qa")))
+	assertions.Equal("qa", cma.environment(WithEnvironment(context.Background(), "qa")))
+}
+
+func TestContentfulEnvironment(t *testing.T) {
+	assertions := assert.New(t)
+
+	var paths []string
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("entry_1.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.baseURL = server.URL
+	cma.SetEnvironment("staging")
+
+	ctx := context.Background()
+	_, err := cma.Entries.Get(ctx, spaceID, "5KsDBWseXY6QegucYAoacS")
+	assertions.Nil(err)
+
+	_, err = cma.Assets.Get(WithEnvironment(ctx, "qa"), spaceID, "1x0xpXu4pSGS4OukSyWGUK")
+	assertions.Nil(err)
+
+	_, err = cma.Locales.Get(WithEnvironment(ctx, ""), spaceID, "4aGeQYgByqQFJtToAOh2JJ")
+	assertions.Nil(err)
+
+	assertions.Equal([]string{
+		"/spaces/id1/environments/staging/entries/5KsDBWseXY6QegucYAoacS",
+		"/spaces/id1/environments/qa/assets/1x0xpXu4pSGS4OukSyWGUK",
+		"/spaces/id1/environments/staging/locales/4aGeQYgByqQFJtToAOh2JJ",
+	}, paths)
+}
+
+func TestContentfulEnvironment_Collection(t *testing.T) {
+	assertions := assert.New(t)
+
+	var requests []string
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.Path+" "+r.URL.Query().Get("environment.sys.id"))
+
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, `{"sys":{"type":"Array"},"total":0,"items":[]}`)
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken, WithBaseURL(server.URL), WithDefaultEnvironment("staging"))
+
+	ctx := context.Background()
+	_, err := cma.Entries.List(spaceID).Next(ctx)
+	assertions.Nil(err)
+
+	// the environment of the context of a page overrides the one of the client
+	_, err = cma.Entries.List(spaceID).Next(WithEnvironment(ctx, "qa"))
+	assertions.Nil(err)
+
+	_, err = cma.ScheduledActions.List(spaceID, "5KsDBWseXY6QegucYAoacS").Next(WithEnvironment(ctx, "qa"))
+	assertions.Nil(err)
+
+	assertions.Equal([]string{
+		"/spaces/id1/environments/staging/entries ",
+		"/spaces/id1/environments/qa/entries ",
+		"/spaces/id1/scheduled_actions qa",
+	}, requests)
 }
 
 func TestContentfulSetClient(t *testing.T) {
@@ -393,11 +495,11 @@ func TestNewRequest(t *testing.T) {
 	query.Add("foo", "bar")
 	query.Add("faz", "zoo")
 
-	expectedURL, _ := url.Parse(c.BaseURL)
+	expectedURL, _ := url.Parse(c.BaseURL())
 	expectedURL.Path = path
 	expectedURL.RawQuery = query.Encode()
 
-	req, err := c.newRequest(method, path, query, nil)
+	req, err := c.newRequest(context.Background(), method, path, query, nil)
 	assertions.Nil(err)
 	assertions.Equal(req.Header.Get("Authorization"), "Bearer "+CMAToken)
 	assertions.Equal(req.Header.Get("Content-Type"), "application/vnd.contentful.management.v1+json")
@@ -414,7 +516,7 @@ func TestNewRequest(t *testing.T) {
 		Age:  10,
 	}
 	body, _ := json.Marshal(bodyData)
-	req, err = c.newRequest(method, path, query, bytes.NewReader(body))
+	req, err = c.newRequest(context.Background(), method, path, query, bytes.NewReader(body))
 	assertions.Nil(err)
 	assertions.Equal(req.Header.Get("Authorization"), "Bearer "+CMAToken)
 	assertions.Equal(req.Header.Get("Content-Type"), "application/vnd.contentful.management.v1+json")
@@ -450,7 +552,7 @@ func TestHandleError(t *testing.T) {
 	errResponseReader := bytes.NewReader(marshaled)
 	errResponseReadCloser := ioutil.NopCloser(errResponseReader)
 
-	req, _ := c.newRequest(method, path, query, nil)
+	req, _ := c.newRequest(context.Background(), method, path, query, nil)
 	responseHeaders := http.Header{}
 	responseHeaders.Add("X-Contentful-Request-Id", requestID)
 	res := &http.Response{
@@ -496,15 +598,56 @@ func TestBackoffForPerSecondLimiting(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	go func() {
 		time.Sleep(time.Second * time.Duration(waitSeconds))
 		rateLimited.Swap(true)
 	}()
 
-	space, err := cma.Spaces.Get("id1")
+	space, err := cma.Spaces.Get(context.Background(), "id1")
 	assertions.Nil(err)
 	assertions.Equal(space.Name, "Contentful Example API")
 	assertions.Equal(space.Sys.ID, "id1")
 }
+
+func TestBackoffForPerSecondLimitingCanceled(t *testing.T) {
+	assertions := assert.New(t)
+	waitSeconds := 30
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Contentful-Ratelimit-Reset", strconv.Itoa(waitSeconds))
+		w.WriteHeader(429)
+
+		_, _ = w.Write([]byte(readTestData("error_ratelimit.json")))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.baseURL = server.URL
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := cma.Spaces.Get(ctx, "id1")
+	assertions.ErrorIs(err, context.DeadlineExceeded)
+	assertions.Less(time.Since(start), time.Second*time.Duration(waitSeconds))
+}
+
+func TestCollectionNextCanceled(t *testing.T) {
+	setup()
+	defer teardown()
+
+	assertions := assert.New(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := c.Spaces.List().Next(ctx)
+	assertions.ErrorIs(err, context.Canceled)
+}
@@ -2,9 +2,11 @@ package contentful
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"strconv"
 )
 
 // EditorInterfacesService service
@@ -33,43 +35,44 @@ type Sidebar struct {
 	Disabled        bool              `json:"disabled"`
 }
 
-// List returns an EditorInterface collection
-func (service *EditorInterfacesService) List(spaceID string) *Collection {
-	path := fmt.Sprintf("/spaces/%s/environments/%s/editor_interface", spaceID, service.c.Environment)
-
-	req, err := service.c.newRequest("GET", path, nil, nil)
-	if err != nil {
-		return &Collection{}
+// GetVersion returns entity version
+func (editorInterface *EditorInterface) GetVersion() int {
+	version := 1
+	if editorInterface.Sys != nil {
+		version = editorInterface.Sys.Version
 	}
 
-	col := NewCollection(&CollectionOptions{})
-	col.c = service.c
-	col.req = req
+	return version
+}
 
-	return col
+// List returns an EditorInterface collection
+func (service *EditorInterfacesService) List(spaceID string) *Collection {
+	return service.c.environmentCollection(func(environment string) string {
+		return fmt.Sprintf("/spaces/%s/environments/%s/editor_interfaces", spaceID, environment)
+	})
 }
 
 // Get returns a single EditorInterface
-func (service *EditorInterfacesService) Get(spaceID, contentTypeID string) (*EditorInterface, error) {
-	path := fmt.Sprintf("/spaces/%s/environments/%s/content_types/%s/editor_interface", spaceID, service.c.Environment, contentTypeID)
+func (service *EditorInterfacesService) Get(ctx context.Context, spaceID, contentTypeID string) (*EditorInterface, error) {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/content_types/%s/editor_interface", spaceID, service.c.environment(ctx), contentTypeID)
 	query := url.Values{}
 	method := "GET"
 
-	req, err := service.c.newRequest(method, path, query, nil)
+	req, err := service.c.newRequest(ctx, method, path, query, nil)
 	if err != nil {
 		return &EditorInterface{}, err
 	}
 
 	var editorInterface EditorInterface
-	if ok := service.c.do(req, &editorInterface); ok != nil {
+	if err := service.c.do(req, &editorInterface); err != nil {
 		return nil, err
 	}
 
-	return &editorInterface, err
+	return &editorInterface, nil
 }
 
 // Update updates an editor interface
-func (service *EditorInterfacesService) Update(spaceID, contentTypeID string, e *EditorInterface) error {
+func (service *EditorInterfacesService) Update(ctx context.Context, spaceID, contentTypeID string, e *EditorInterface) error {
 	bytesArray, err := json.Marshal(e)
 	if err != nil {
 		return err
@@ -79,14 +82,16 @@ func (service *EditorInterfacesService) Update(spaceID, contentTypeID string, e
 	var method string
 
 	if contentTypeID != "" {
-		path = fmt.Sprintf("/spaces/%s/environments/%s/content_types/%s/editor_interface", spaceID, service.c.Environment, contentTypeID)
+		path = fmt.Sprintf("/spaces/%s/environments/%s/content_types/%s/editor_interface", spaceID, service.c.environment(ctx), contentTypeID)
 		method = "PUT"
 	}
 
-	req, err := service.c.newRequest(method, path, nil, bytes.NewReader(bytesArray))
+	req, err := service.c.newRequest(ctx, method, path, nil, bytes.NewReader(bytesArray))
 	if err != nil {
 		return err
 	}
 
+	req.Header.Set("X-Contentful-Version", strconv.Itoa(e.GetVersion()))
+
 	return service.c.do(req, e)
 }
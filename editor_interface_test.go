@@ -1,6 +1,7 @@
 package contentful
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -16,7 +17,7 @@ func TestEditorInterfacesService_List(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "GET")
-		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/master/editor_interface")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/master/editor_interfaces")
 
 		checkHeaders(r, assertions)
 
@@ -30,9 +31,9 @@ func TestEditorInterfacesService_List(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	collection, err := cma.EditorInterfaces.List(spaceID).Next()
+	collection, err := cma.EditorInterfaces.List(spaceID).Next(context.Background())
 	assertions.Nil(err)
 
 	interfaces := collection.ToEditorInterface()
@@ -61,9 +62,9 @@ func TestEditorInterfacesService_Get(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	editorInterface, err := cma.EditorInterfaces.Get(spaceID, "hfM9RCJIk0wIm06WkEOQY")
+	editorInterface, err := cma.EditorInterfaces.Get(context.Background(), spaceID, "hfM9RCJIk0wIm06WkEOQY")
 	assertions.Nil(err)
 	assertions.Equal("name", editorInterface.Controls[0].FieldID)
 	assertions.Equal("extension", editorInterface.SideBar[0].WidgetNameSpace)
@@ -89,10 +90,11 @@ func TestEditorInterfacesService_Get_2(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	_, err = cma.EditorInterfaces.Get(spaceID, "hfM9RCJIk0wIm06WkEOQY")
-	assertions.Nil(err)
+	editorInterface, err := cma.EditorInterfaces.Get(context.Background(), spaceID, "hfM9RCJIk0wIm06WkEOQY")
+	assertions.NotNil(err)
+	assertions.Nil(editorInterface)
 }
 
 func TestEditorInterfacesService_Update(t *testing.T) {
@@ -102,6 +104,7 @@ func TestEditorInterfacesService_Update(t *testing.T) {
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "PUT")
 		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/environments/master/content_types/hfM9RCJIk0wIm06WkEOQY/editor_interface")
+		assertions.Equal("3", r.Header.Get("X-Contentful-Version"))
 		checkHeaders(r, assertions)
 
 		var payload map[string]interface{}
@@ -123,14 +126,14 @@ func TestEditorInterfacesService_Update(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	editorInterface, err := editorInterfaceFromTestFile("editor_interface_1.json")
 	assertions.Nil(err)
 
 	editorInterface.Controls[0].WidgetID = "changed id"
 
-	err = cma.EditorInterfaces.Update(spaceID, "hfM9RCJIk0wIm06WkEOQY", editorInterface)
+	err = cma.EditorInterfaces.Update(context.Background(), spaceID, "hfM9RCJIk0wIm06WkEOQY", editorInterface)
 	assertions.Nil(err)
 	assertions.Equal("changed id", editorInterface.Controls[0].WidgetID)
 }
@@ -2,9 +2,9 @@ package contentful
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
-	"net/http"
 	"net/url"
 	"strconv"
 )
@@ -31,64 +31,46 @@ func (entry *Entry) GetVersion() int {
 
 // List returns entries collection
 func (service *EntriesService) List(spaceID string) *Collection {
-	path := fmt.Sprintf("/spaces/%s/environments/%s/entries", spaceID, service.c.Environment)
-
-	req, err := service.c.newRequest(http.MethodGet, path, nil, nil)
-	if err != nil {
-		return &Collection{}
-	}
-
-	col := NewCollection(&CollectionOptions{})
-	col.c = service.c
-	col.req = req
-
-	return col
+	return service.c.environmentCollection(func(environment string) string {
+		return fmt.Sprintf("/spaces/%s/environments/%s/entries", spaceID, environment)
+	})
 }
 
 // List returns entries collection
 func (service *EntriesService) ListWithContentType(spaceID, contentType string) *Collection {
-	path := fmt.Sprintf("/spaces/%s/environments/%s/entries", spaceID, service.c.Environment)
-	uv := url.Values{}
-	uv.Add("content_type", contentType)
-
-	req, err := service.c.newRequest(http.MethodGet, path, uv, nil)
-	if err != nil {
-		return &Collection{}
-	}
-
-	col := NewCollection(&CollectionOptions{})
-	col.c = service.c
-	col.req = req
+	col := service.c.environmentCollection(func(environment string) string {
+		return fmt.Sprintf("/spaces/%s/environments/%s/entries", spaceID, environment)
+	})
 	col.contentType = contentType
 
 	return col
 }
 
 // Get returns a single entry
-func (service *EntriesService) Get(spaceID, entryID string) (*Entry, error) {
-	path := fmt.Sprintf("/spaces/%s/entries/%s", spaceID, entryID)
+func (service *EntriesService) Get(ctx context.Context, spaceID, entryID string) (*Entry, error) {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/entries/%s", spaceID, service.c.environment(ctx), entryID)
 	query := url.Values{}
 	method := "GET"
 
-	req, err := service.c.newRequest(method, path, query, nil)
+	req, err := service.c.newRequest(ctx, method, path, query, nil)
 	if err != nil {
 		return &Entry{}, err
 	}
 
 	var entry Entry
-	if ok := service.c.do(req, &entry); ok != nil {
+	if err := service.c.do(req, &entry); err != nil {
 		return nil, err
 	}
 
-	return &entry, err
+	return &entry, nil
 }
 
 // Delete the entry
-func (service *EntriesService) Delete(spaceID string, entryID string) error {
-	path := fmt.Sprintf("/spaces/%s/entries/%s", spaceID, entryID)
+func (service *EntriesService) Delete(ctx context.Context, spaceID string, entryID string) error {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/entries/%s", spaceID, service.c.environment(ctx), entryID)
 	method := "DELETE"
 
-	req, err := service.c.newRequest(method, path, nil, nil)
+	req, err := service.c.newRequest(ctx, method, path, nil, nil)
 	if err != nil {
 		return err
 	}
@@ -97,11 +79,11 @@ func (service *EntriesService) Delete(spaceID string, entryID string) error {
 }
 
 // Publish the entry
-func (service *EntriesService) Publish(spaceID string, entry *Entry) error {
-	path := fmt.Sprintf("/spaces/%s/entries/%s/published", spaceID, entry.Sys.ID)
+func (service *EntriesService) Publish(ctx context.Context, spaceID string, entry *Entry) error {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/entries/%s/published", spaceID, service.c.environment(ctx), entry.Sys.ID)
 	method := "PUT"
 
-	req, err := service.c.newRequest(method, path, nil, nil)
+	req, err := service.c.newRequest(ctx, method, path, nil, nil)
 	if err != nil {
 		return err
 	}
@@ -113,11 +95,11 @@ func (service *EntriesService) Publish(spaceID string, entry *Entry) error {
 }
 
 // Unpublish the entry
-func (service *EntriesService) Unpublish(spaceID string, entry *Entry) error {
-	path := fmt.Sprintf("/spaces/%s/entries/%s/published", spaceID, entry.Sys.ID)
+func (service *EntriesService) Unpublish(ctx context.Context, spaceID string, entry *Entry) error {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/entries/%s/published", spaceID, service.c.environment(ctx), entry.Sys.ID)
 	method := "DELETE"
 
-	req, err := service.c.newRequest(method, path, nil, nil)
+	req, err := service.c.newRequest(ctx, method, path, nil, nil)
 	if err != nil {
 		return err
 	}
@@ -129,7 +111,7 @@ func (service *EntriesService) Unpublish(spaceID string, entry *Entry) error {
 }
 
 // Upsert updates or creates a new entry
-func (service *EntriesService) Upsert(spaceID, contentTypeID string, e *Entry) error {
+func (service *EntriesService) Upsert(ctx context.Context, spaceID, contentTypeID string, e *Entry) error {
 	bytesArray, err := json.Marshal(e)
 	if err != nil {
 		return err
@@ -139,14 +121,14 @@ func (service *EntriesService) Upsert(spaceID, contentTypeID string, e *Entry) e
 	var method string
 
 	if e.Sys != nil && e.Sys.ID != "" {
-		path = fmt.Sprintf("/spaces/%s/entries/%s", spaceID, e.Sys.ID)
+		path = fmt.Sprintf("/spaces/%s/environments/%s/entries/%s", spaceID, service.c.environment(ctx), e.Sys.ID)
 		method = "PUT"
 	} else {
-		path = fmt.Sprintf("/spaces/%s/entries", spaceID)
+		path = fmt.Sprintf("/spaces/%s/environments/%s/entries", spaceID, service.c.environment(ctx))
 		method = "POST"
 	}
 
-	req, err := service.c.newRequest(method, path, nil, bytes.NewReader(bytesArray))
+	req, err := service.c.newRequest(ctx, method, path, nil, bytes.NewReader(bytesArray))
 	if err != nil {
 		return err
 	}
@@ -158,11 +140,11 @@ func (service *EntriesService) Upsert(spaceID, contentTypeID string, e *Entry) e
 }
 
 // Archive the entry
-func (service *EntriesService) Archive(spaceID string, entry *Entry) error {
-	path := fmt.Sprintf("/spaces/%s/entries/%s/archived", spaceID, entry.Sys.ID)
+func (service *EntriesService) Archive(ctx context.Context, spaceID string, entry *Entry) error {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/entries/%s/archived", spaceID, service.c.environment(ctx), entry.Sys.ID)
 	method := "PUT"
 
-	req, err := service.c.newRequest(method, path, nil, nil)
+	req, err := service.c.newRequest(ctx, method, path, nil, nil)
 	if err != nil {
 		return err
 	}
@@ -174,11 +156,11 @@ func (service *EntriesService) Archive(spaceID string, entry *Entry) error {
 }
 
 // Unarchive the entry
-func (service *EntriesService) Unarchive(spaceID string, entry *Entry) error {
-	path := fmt.Sprintf("/spaces/%s/entries/%s/archived", spaceID, entry.Sys.ID)
+func (service *EntriesService) Unarchive(ctx context.Context, spaceID string, entry *Entry) error {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/entries/%s/archived", spaceID, service.c.environment(ctx), entry.Sys.ID)
 	method := "DELETE"
 
-	req, err := service.c.newRequest(method, path, nil, nil)
+	req, err := service.c.newRequest(ctx, method, path, nil, nil)
 	if err != nil {
 		return err
 	}
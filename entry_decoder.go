@@ -0,0 +1,278 @@
+package contentful
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// ErrLocaleRequired is returned when decoding localized fields without a locale
+var ErrLocaleRequired = errors.New("contentful: a locale is required to decode localized fields")
+
+// dateLayouts are the formats accepted by Date fields
+var dateLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04Z07:00",
+	"2006-01-02T15:04",
+	"2006-01-02",
+}
+
+var (
+	timeType  = reflect.TypeOf(time.Time{})
+	entryType = reflect.TypeOf(&Entry{})
+	assetType = reflect.TypeOf(&Asset{})
+	sysType   = reflect.TypeOf(&Sys{})
+	docType   = reflect.TypeOf(Document{})
+)
+
+// Decode decodes the fields of the entry into the struct pointed to by v, using
+// the locale of the entry. See DecodeLocale.
+func (entry *Entry) Decode(v interface{}) error {
+	return entry.DecodeLocale(v, "")
+}
+
+// DecodeLocale decodes the fields of the entry into the struct pointed to by v.
+//
+// Struct fields are mapped with the `contentful` tag, e.g. `contentful:"title"`,
+// or by a case insensitive match of their name when untagged; a tag of "-"
+// skips the field and the "sys" tag receives the Sys of the entry. Localized
+// fields, as returned by the management api or the delivery api with
+// locale=*, are read for the given locale, which defaults to the locale of
+// the entry. Date fields are decoded into time.Time, Location fields into
+// Location, RichText fields into Document, and links resolved by
+// Collection.ResolveLinks into nested structs.
+func (entry *Entry) DecodeLocale(v interface{}, locale string) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("contentful: decode target must be a non-nil pointer to a struct, got %T", v)
+	}
+
+	return entry.decode(rv.Elem(), locale)
+}
+
+// DecodeEntries decodes the entries of the collection into T for the given
+// locale, resolving their links from the includes first. Unresolved links are
+// not considered an error.
+func DecodeEntries[T any](col *Collection, locale string) ([]T, error) {
+	entries, err := col.ResolveLinks()
+	if err != nil && entries == nil {
+		return nil, err
+	}
+
+	result := make([]T, 0, len(entries))
+	for _, entry := range entries {
+		var item T
+		if err := entry.DecodeLocale(&item, locale); err != nil {
+			return nil, err
+		}
+
+		result = append(result, item)
+	}
+
+	return result, nil
+}
+
+// localized reports whether the fields of the entry are keyed by locale
+func (entry *Entry) localized() bool {
+	return entry.Sys == nil || entry.Sys.Locale == ""
+}
+
+func (entry *Entry) decode(target reflect.Value, locale string) error {
+	if locale == "" {
+		locale = entry.Locale
+	}
+
+	if locale == "" && entry.Sys != nil {
+		locale = entry.Sys.Locale
+	}
+
+	targetType := target.Type()
+	for i := 0; i < targetType.NumField(); i++ {
+		field := targetType.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+
+		name := field.Tag.Get("contentful")
+		if name == "-" {
+			continue
+		}
+
+		if name == "sys" {
+			if entry.Sys == nil {
+				continue
+			}
+
+			if err := assign(target.Field(i), entry.Sys, locale); err != nil {
+				return fmt.Errorf("contentful: field %s: %w", field.Name, err)
+			}
+
+			continue
+		}
+
+		value, ok := entry.field(name, field.Name)
+		if !ok {
+			continue
+		}
+
+		if entry.localized() {
+			localizedValue, ok := value.(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("contentful: field %s is not localized", field.Name)
+			}
+
+			if locale == "" {
+				if len(localizedValue) != 1 {
+					return ErrLocaleRequired
+				}
+
+				for _, v := range localizedValue {
+					value = v
+				}
+			} else if value, ok = localizedValue[locale]; !ok {
+				continue
+			}
+		}
+
+		if err := assign(target.Field(i), value, locale); err != nil {
+			return fmt.Errorf("contentful: field %s: %w", field.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// field looks up a field by its id, or by the struct field name
+func (entry *Entry) field(id, name string) (interface{}, bool) {
+	if id != "" {
+		value, ok := entry.Fields[id]
+		return value, ok
+	}
+
+	for key, value := range entry.Fields {
+		if strings.EqualFold(key, name) {
+			return value, true
+		}
+	}
+
+	return nil, false
+}
+
+// assign converts a decoded json value into the target
+func assign(target reflect.Value, value interface{}, locale string) error {
+	if value == nil {
+		return nil
+	}
+
+	targetType := target.Type()
+
+	switch v := value.(type) {
+	case *Entry:
+		switch {
+		case targetType == entryType:
+			target.Set(reflect.ValueOf(v))
+			return nil
+		case targetType.Kind() == reflect.Struct:
+			return v.decode(target, locale)
+		}
+	case *Asset:
+		switch {
+		case targetType == assetType:
+			target.Set(reflect.ValueOf(v))
+			return nil
+		case targetType == assetType.Elem():
+			target.Set(reflect.ValueOf(*v))
+			return nil
+		}
+	case *Sys:
+		switch {
+		case targetType == sysType:
+			target.Set(reflect.ValueOf(v))
+			return nil
+		case targetType == sysType.Elem():
+			target.Set(reflect.ValueOf(*v))
+			return nil
+		}
+	case string:
+		if targetType == timeType {
+			t, err := ParseDate(v)
+			if err != nil {
+				return err
+			}
+
+			target.Set(reflect.ValueOf(t))
+			return nil
+		}
+	case map[string]interface{}:
+		// rich text keeps its resolved links
+		if targetType == docType {
+			doc, err := ParseRichText(v)
+			if err != nil {
+				return err
+			}
+
+			target.Set(reflect.ValueOf(*doc))
+			return nil
+		}
+	case []interface{}:
+		if targetType.Kind() == reflect.Slice {
+			slice := reflect.MakeSlice(targetType, len(v), len(v))
+			for i, item := range v {
+				if err := assign(slice.Index(i), item, locale); err != nil {
+					return err
+				}
+			}
+
+			target.Set(slice)
+			return nil
+		}
+	}
+
+	if targetType.Kind() == reflect.Ptr {
+		nested := reflect.New(targetType.Elem())
+		if err := assign(nested.Elem(), value, locale); err != nil {
+			return err
+		}
+
+		target.Set(nested)
+		return nil
+	}
+
+	if targetType.Kind() == reflect.Interface {
+		rv := reflect.ValueOf(value)
+		if !rv.Type().AssignableTo(targetType) {
+			return fmt.Errorf("can not assign %T to %s", value, targetType)
+		}
+
+		target.Set(rv)
+		return nil
+	}
+
+	// scalars, locations, objects and rich text go through their json representation
+	byteArray, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(byteArray, target.Addr().Interface()); err != nil {
+		return fmt.Errorf("can not decode %T into %s: %w", value, targetType, err)
+	}
+
+	return nil
+}
+
+// ParseDate parses the value of a Date field, which may omit the seconds, the
+// time zone or the time altogether
+func ParseDate(value string) (time.Time, error) {
+	for _, layout := range dateLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("contentful: invalid date %q", value)
+}
@@ -0,0 +1,110 @@
+package contentful
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testCat struct {
+	Sys        *Sys      `contentful:"sys"`
+	Name       string    `contentful:"name"`
+	Lives      int       `contentful:"lives"`
+	Likes      []string  `contentful:"likes"`
+	Birthday   time.Time `contentful:"birthday"`
+	Location   *Location `contentful:"location"`
+	BestFriend *testCat  `contentful:"bestFriend"`
+	Image      *Asset    `contentful:"image"`
+	Color      string
+	Ignored    string `contentful:"-"`
+}
+
+func TestEntry_DecodeLocale(t *testing.T) {
+	assertions := assert.New(t)
+
+	entry := &Entry{
+		Sys: &Sys{ID: "nyancat"},
+		Fields: map[string]interface{}{
+			"name":     map[string]interface{}{"en-US": "Nyan Cat", "tlh": "Nyan vIghro'"},
+			"lives":    map[string]interface{}{"en-US": float64(1337)},
+			"likes":    map[string]interface{}{"en-US": []interface{}{"rainbows", "fish"}},
+			"birthday": map[string]interface{}{"en-US": "2011-04-04T22:00+00:00"},
+			"location": map[string]interface{}{"en-US": map[string]interface{}{"lat": 52.5, "lon": 13.4}},
+			"color":    map[string]interface{}{"en-US": "rainbow"},
+			"ignored":  map[string]interface{}{"en-US": "value"},
+		},
+	}
+
+	var cat testCat
+	err := entry.DecodeLocale(&cat, "tlh")
+	assertions.Nil(err)
+	assertions.Equal("nyancat", cat.Sys.ID)
+	assertions.Equal("Nyan vIghro'", cat.Name)
+	assertions.Equal(0, cat.Lives)
+
+	err = entry.DecodeLocale(&cat, "en-US")
+	assertions.Nil(err)
+	assertions.Equal("Nyan Cat", cat.Name)
+	assertions.Equal(1337, cat.Lives)
+	assertions.Equal([]string{"rainbows", "fish"}, cat.Likes)
+	assertions.Equal(time.Date(2011, 4, 4, 22, 0, 0, 0, time.UTC), cat.Birthday.UTC())
+	assertions.Equal(&Location{Lat: 52.5, Lon: 13.4}, cat.Location)
+	assertions.Equal("rainbow", cat.Color)
+	assertions.Empty(cat.Ignored)
+
+	err = entry.Decode(&cat)
+	assertions.ErrorIs(err, ErrLocaleRequired)
+}
+
+func TestEntry_DecodeErrors(t *testing.T) {
+	assertions := assert.New(t)
+
+	entry := &Entry{
+		Sys: &Sys{ID: "nyancat", Locale: "en-US"},
+		Fields: map[string]interface{}{
+			"lives": "nine",
+		},
+	}
+
+	var cat testCat
+	assertions.NotNil(entry.Decode(cat))
+	assertions.NotNil(entry.Decode(&cat))
+}
+
+func TestDecodeEntries(t *testing.T) {
+	assertions := assert.New(t)
+
+	cats, err := DecodeEntries[testCat](includesCollection(t, 2), "en-US")
+	assertions.Nil(err)
+	assertions.Len(cats, 1)
+
+	nyanCat := cats[0]
+	assertions.Equal("Nyan Cat", nyanCat.Name)
+	assertions.Equal("nyancat", nyanCat.Sys.ID)
+	assertions.Equal("Happy Cat", nyanCat.BestFriend.Name)
+	assertions.Equal("happycat", nyanCat.BestFriend.Sys.ID)
+	assertions.Equal("nyancat.png", nyanCat.Image.Fields.File["en-US"].FileName)
+
+	// the link back to nyan cat is a cycle and decodes into an empty struct
+	assertions.Empty(nyanCat.BestFriend.BestFriend.Name)
+}
+
+func TestParseDate(t *testing.T) {
+	assertions := assert.New(t)
+
+	for _, value := range []string{
+		"2015-11-06T09:45:27.000Z",
+		"2015-11-06T09:45:27",
+		"2015-11-06T09:45+01:00",
+		"2015-11-06T09:45",
+		"2015-11-06",
+	} {
+		date, err := ParseDate(value)
+		assertions.Nil(err, value)
+		assertions.Equal(2015, date.Year())
+	}
+
+	_, err := ParseDate("yesterday")
+	assertions.NotNil(err)
+}
@@ -0,0 +1,341 @@
+package contentful
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrFieldNotSet is returned by the accessors of an entry when a field has no
+// value in the locales it is read in
+var ErrFieldNotSet = errors.New("contentful: field is not set")
+
+// LocaleFallbacks maps the code of each locale of an environment to the code
+// of its fallback locale
+type LocaleFallbacks map[string]string
+
+// NewLocaleFallbacks returns the fallbacks of the locales
+func NewLocaleFallbacks(locales []*Locale) LocaleFallbacks {
+	fallbacks := LocaleFallbacks{}
+	for _, locale := range locales {
+		fallbacks[locale.Code] = locale.FallbackCode
+	}
+
+	return fallbacks
+}
+
+// Chain returns the locale followed by its fallback, the fallback of its
+// fallback and so on, which is the order in which the api looks up values
+func (fallbacks LocaleFallbacks) Chain(code string) []string {
+	chain := []string{}
+	seen := map[string]bool{}
+
+	for code != "" && !seen[code] {
+		seen[code] = true
+		chain = append(chain, code)
+		code = fallbacks[code]
+	}
+
+	return chain
+}
+
+// Fallbacks returns the fallbacks of the locales of the environment
+func (service *LocalesService) Fallbacks(ctx context.Context, spaceID string) (LocaleFallbacks, error) {
+	locales, err := All[*Locale](ctx, service.List(spaceID))
+	if err != nil {
+		return nil, err
+	}
+
+	return NewLocaleFallbacks(locales), nil
+}
+
+// GetField returns the value of a field in the first of the locales it is
+// set in. Pass the fallback chain of a locale to read it like the api does:
+//
+//	fallbacks, err := cma.Locales.Fallbacks(ctx, spaceID)
+//	title, err := entry.GetString("title", fallbacks.Chain("de-CH")...)
+//
+// Without locales, the field is read in the locale of the entry, or in its
+// only locale. The fields of entries of the delivery api requested for a
+// single locale are not keyed by locale; the api already applied the
+// fallbacks, and their value is returned when the locale of the entry is one
+// of the locales. ErrFieldNotSet is returned when the field has no value.
+func (entry *Entry) GetField(id string, locales ...string) (interface{}, error) {
+	value, ok := entry.Fields[id]
+	if !ok || value == nil {
+		return nil, fmt.Errorf("%w: %s", ErrFieldNotSet, id)
+	}
+
+	if !entry.localized() {
+		if len(locales) > 0 && !contains(locales, entry.Sys.Locale) {
+			return nil, fmt.Errorf("%w: %s", ErrFieldNotSet, id)
+		}
+
+		return value, nil
+	}
+
+	localizedValue, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("contentful: field %s is not localized", id)
+	}
+
+	if len(locales) == 0 {
+		if entry.Locale == "" {
+			if len(localizedValue) != 1 {
+				return nil, ErrLocaleRequired
+			}
+
+			for _, v := range localizedValue {
+				return v, nil
+			}
+		}
+
+		locales = []string{entry.Locale}
+	}
+
+	for _, locale := range locales {
+		if v, ok := localizedValue[locale]; ok && v != nil {
+			return v, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrFieldNotSet, id)
+}
+
+// GetString returns the value of a Symbol or Text field, see GetField
+func (entry *Entry) GetString(id string, locales ...string) (string, error) {
+	value, err := entry.GetField(id, locales...)
+	if err != nil {
+		return "", err
+	}
+
+	s, ok := value.(string)
+	if !ok {
+		return "", fieldTypeError(id, value, "a string")
+	}
+
+	return s, nil
+}
+
+// GetInt returns the value of an Integer field, see GetField
+func (entry *Entry) GetInt(id string, locales ...string) (int, error) {
+	value, err := entry.GetField(id, locales...)
+	if err != nil {
+		return 0, err
+	}
+
+	number, ok := asNumber(value)
+	if !ok || number != float64(int(number)) {
+		return 0, fieldTypeError(id, value, "an integer")
+	}
+
+	return int(number), nil
+}
+
+// GetFloat returns the value of a Number or Integer field, see GetField
+func (entry *Entry) GetFloat(id string, locales ...string) (float64, error) {
+	value, err := entry.GetField(id, locales...)
+	if err != nil {
+		return 0, err
+	}
+
+	number, ok := asNumber(value)
+	if !ok {
+		return 0, fieldTypeError(id, value, "a number")
+	}
+
+	return number, nil
+}
+
+// GetBool returns the value of a Boolean field, see GetField
+func (entry *Entry) GetBool(id string, locales ...string) (bool, error) {
+	value, err := entry.GetField(id, locales...)
+	if err != nil {
+		return false, err
+	}
+
+	b, ok := value.(bool)
+	if !ok {
+		return false, fieldTypeError(id, value, "a boolean")
+	}
+
+	return b, nil
+}
+
+// GetTime returns the value of a Date field, see GetField and ParseDate
+func (entry *Entry) GetTime(id string, locales ...string) (time.Time, error) {
+	value, err := entry.GetField(id, locales...)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	switch v := value.(type) {
+	case time.Time:
+		return v, nil
+	case string:
+		t, err := ParseDate(v)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("contentful: field %s: %w", id, err)
+		}
+
+		return t, nil
+	}
+
+	return time.Time{}, fieldTypeError(id, value, "a date")
+}
+
+// GetLink returns the link of a Link field, whose Sys holds the link type and
+// the id of the target. Links resolved to an *Entry or *Asset return the link
+// to the resolved entity. See GetField.
+func (entry *Entry) GetLink(id string, locales ...string) (*Sys, error) {
+	value, err := entry.GetField(id, locales...)
+	if err != nil {
+		return nil, err
+	}
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if linkType, linkID, ok := parseLink(v); ok {
+			return &Sys{Type: "Link", LinkType: linkType, ID: linkID}, nil
+		}
+	case *Entry:
+		if v.Sys != nil {
+			return &Sys{Type: "Link", LinkType: "Entry", ID: v.Sys.ID}, nil
+		}
+	case *Asset:
+		if v.Sys != nil {
+			return &Sys{Type: "Link", LinkType: "Asset", ID: v.Sys.ID}, nil
+		}
+	}
+
+	return nil, fieldTypeError(id, value, "a link")
+}
+
+// GetLocation returns the value of a Location field, see GetField
+func (entry *Entry) GetLocation(id string, locales ...string) (Location, error) {
+	value, err := entry.GetField(id, locales...)
+	if err != nil {
+		return Location{}, err
+	}
+
+	switch v := value.(type) {
+	case Location:
+		return v, nil
+	case *Location:
+		return *v, nil
+	case map[string]interface{}:
+		lat, latOK := asNumber(v["lat"])
+		lon, lonOK := asNumber(v["lon"])
+		if latOK && lonOK {
+			return Location{Lat: lat, Lon: lon}, nil
+		}
+	}
+
+	return Location{}, fieldTypeError(id, value, "a location")
+}
+
+// GetArray returns the items of an Array field, see GetField
+func (entry *Entry) GetArray(id string, locales ...string) ([]interface{}, error) {
+	value, err := entry.GetField(id, locales...)
+	if err != nil {
+		return nil, err
+	}
+
+	items, ok := asSlice(value)
+	if !ok {
+		return nil, fieldTypeError(id, value, "an array")
+	}
+
+	return items, nil
+}
+
+// GetRichText returns the value of a RichText field, see GetField and
+// ParseRichText
+func (entry *Entry) GetRichText(id string, locales ...string) (*Document, error) {
+	value, err := entry.GetField(id, locales...)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := ParseRichText(value)
+	if err != nil {
+		return nil, fmt.Errorf("contentful: field %s: %w", id, err)
+	}
+
+	return doc, nil
+}
+
+func fieldTypeError(id string, value interface{}, expected string) error {
+	return fmt.Errorf("contentful: field %s is %T, not %s", id, value, expected)
+}
+
+// SetField sets the value of a field in a locale, keeping its other locales,
+// as expected by EntriesService.Upsert. The flat fields of an entry of the
+// delivery api are first keyed by the locale of the entry.
+func (entry *Entry) SetField(id, locale string, value interface{}) {
+	if !entry.localized() {
+		sys := *entry.Sys
+		sys.Locale = ""
+
+		for key, v := range entry.Fields {
+			entry.Fields[key] = map[string]interface{}{entry.Sys.Locale: v}
+		}
+
+		if entry.Locale == "" {
+			entry.Locale = entry.Sys.Locale
+		}
+
+		// the sys may be shared with resolved links to the entry
+		entry.Sys = &sys
+	}
+
+	setLocalizedField(entry, id, locale, value)
+}
+
+// SetString sets the value of a Symbol or Text field, see SetField
+func (entry *Entry) SetString(id, locale, value string) {
+	entry.SetField(id, locale, value)
+}
+
+// SetInt sets the value of an Integer field, see SetField
+func (entry *Entry) SetInt(id, locale string, value int) {
+	entry.SetField(id, locale, value)
+}
+
+// SetFloat sets the value of a Number field, see SetField
+func (entry *Entry) SetFloat(id, locale string, value float64) {
+	entry.SetField(id, locale, value)
+}
+
+// SetBool sets the value of a Boolean field, see SetField
+func (entry *Entry) SetBool(id, locale string, value bool) {
+	entry.SetField(id, locale, value)
+}
+
+// SetTime sets the value of a Date field, formatted with its time zone, see SetField
+func (entry *Entry) SetTime(id, locale string, value time.Time) {
+	entry.SetField(id, locale, value.Format(time.RFC3339))
+}
+
+// SetLink sets a Link field to the entry or asset with the given id, see SetField
+func (entry *Entry) SetLink(id, locale, linkType, targetID string) {
+	entry.SetField(id, locale, map[string]interface{}{
+		"sys": map[string]interface{}{"type": "Link", "linkType": linkType, "id": targetID},
+	})
+}
+
+// SetLocation sets the value of a Location field, see SetField
+func (entry *Entry) SetLocation(id, locale string, value Location) {
+	entry.SetField(id, locale, map[string]interface{}{"lat": value.Lat, "lon": value.Lon})
+}
+
+// SetArray sets the items of an Array field, see SetField
+func (entry *Entry) SetArray(id, locale string, items []interface{}) {
+	entry.SetField(id, locale, items)
+}
+
+// SetRichText sets the value of a RichText field, see SetField
+func (entry *Entry) SetRichText(id, locale string, doc *Document) {
+	entry.SetField(id, locale, doc)
+}
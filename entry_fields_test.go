@@ -0,0 +1,219 @@
+package contentful
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func localizedEntry() *Entry {
+	return &Entry{
+		Sys: &Sys{ID: "post"},
+		Fields: map[string]interface{}{
+			"title":    map[string]interface{}{"en-US": "Hello", "de-DE": "Hallo"},
+			"views":    map[string]interface{}{"en-US": float64(42)},
+			"rating":   map[string]interface{}{"en-US": 4.5},
+			"featured": map[string]interface{}{"en-US": true},
+			"date":     map[string]interface{}{"en-US": "2021-03-04T10:00"},
+			"author":   map[string]interface{}{"en-US": testLink("Entry", "jane")},
+			"venue":    map[string]interface{}{"en-US": map[string]interface{}{"lat": 52.5, "lon": 13.4}},
+			"tags":     map[string]interface{}{"en-US": []interface{}{"news", "tech"}},
+			"body": map[string]interface{}{"en-US": map[string]interface{}{
+				"nodeType": "document",
+				"data":     map[string]interface{}{},
+				"content": []interface{}{
+					map[string]interface{}{"nodeType": "paragraph", "data": map[string]interface{}{}, "content": []interface{}{
+						map[string]interface{}{"nodeType": "text", "value": "Hi", "marks": []interface{}{}, "data": map[string]interface{}{}},
+					}},
+				},
+			}},
+		},
+	}
+}
+
+func TestEntry_Getters(t *testing.T) {
+	assertions := assert.New(t)
+
+	entry := localizedEntry()
+	chain := LocaleFallbacks{"de-CH": "de-DE", "de-DE": "en-US", "en-US": ""}.Chain("de-CH")
+	assertions.Equal([]string{"de-CH", "de-DE", "en-US"}, chain)
+
+	title, err := entry.GetString("title", chain...)
+	assertions.Nil(err)
+	assertions.Equal("Hallo", title)
+
+	views, err := entry.GetInt("views", chain...)
+	assertions.Nil(err)
+	assertions.Equal(42, views)
+
+	rating, err := entry.GetFloat("rating", "en-US")
+	assertions.Nil(err)
+	assertions.Equal(4.5, rating)
+
+	featured, err := entry.GetBool("featured", "en-US")
+	assertions.Nil(err)
+	assertions.True(featured)
+
+	date, err := entry.GetTime("date", chain...)
+	assertions.Nil(err)
+	assertions.Equal(time.Date(2021, 3, 4, 10, 0, 0, 0, time.UTC), date)
+
+	author, err := entry.GetLink("author", "en-US")
+	assertions.Nil(err)
+	assertions.Equal(&Sys{Type: "Link", LinkType: "Entry", ID: "jane"}, author)
+
+	venue, err := entry.GetLocation("venue", "en-US")
+	assertions.Nil(err)
+	assertions.Equal(Location{Lat: 52.5, Lon: 13.4}, venue)
+
+	tags, err := entry.GetArray("tags", "en-US")
+	assertions.Nil(err)
+	assertions.Equal([]interface{}{"news", "tech"}, tags)
+
+	body, err := entry.GetRichText("body", "en-US")
+	assertions.Nil(err)
+	assertions.Equal("Hi", body.Content[0].Content[0].Value)
+
+	// without a fallback, the locale has no value
+	_, err = entry.GetString("views", "de-DE")
+	assertions.True(errors.Is(err, ErrFieldNotSet))
+
+	_, err = entry.GetString("missing", "en-US")
+	assertions.True(errors.Is(err, ErrFieldNotSet))
+
+	_, err = entry.GetInt("rating", "en-US")
+	assertions.EqualError(err, "contentful: field rating is float64, not an integer")
+
+	// the locale of the entry is used by default
+	_, err = entry.GetString("title")
+	assertions.Equal(ErrLocaleRequired, err)
+
+	entry.Locale = "de-DE"
+	title, err = entry.GetString("title")
+	assertions.Nil(err)
+	assertions.Equal("Hallo", title)
+}
+
+func TestEntry_Getters_Delivery(t *testing.T) {
+	assertions := assert.New(t)
+
+	var entry Entry
+	assertions.Nil(json.Unmarshal([]byte(readTestData("spaces-id1-entries-nyancat.json")), &entry))
+
+	name, err := entry.GetString("name")
+	assertions.Nil(err)
+	assertions.Equal("Nyan Cat", name)
+
+	// the delivery api applied the fallbacks of the locale of the entry
+	name, err = entry.GetString("name", "de-DE", "en-US")
+	assertions.Nil(err)
+	assertions.Equal("Nyan Cat", name)
+
+	_, err = entry.GetString("name", "de-DE")
+	assertions.True(errors.Is(err, ErrFieldNotSet))
+
+	lives, err := entry.GetInt("lives")
+	assertions.Nil(err)
+	assertions.Equal(1337, lives)
+
+	birthday, err := entry.GetTime("birthday")
+	assertions.Nil(err)
+	assertions.Equal(2011, birthday.Year())
+
+	friend, err := entry.GetLink("bestFriend")
+	assertions.Nil(err)
+	assertions.Equal("happycat", friend.ID)
+}
+
+func TestEntry_Setters(t *testing.T) {
+	assertions := assert.New(t)
+
+	entry := &Entry{}
+	entry.SetString("title", "en-US", "Hello")
+	entry.SetString("title", "de-DE", "Hallo")
+	entry.SetInt("views", "en-US", 42)
+	entry.SetFloat("rating", "en-US", 4.5)
+	entry.SetBool("featured", "en-US", true)
+	entry.SetTime("date", "en-US", time.Date(2021, 3, 4, 10, 0, 0, 0, time.UTC))
+	entry.SetLink("author", "en-US", "Entry", "jane")
+	entry.SetLocation("venue", "en-US", Location{Lat: 52.5, Lon: 13.4})
+	entry.SetArray("tags", "en-US", []interface{}{"news"})
+	entry.SetRichText("body", "en-US", &Document{Content: []*Node{{NodeType: NodeTypeParagraph, Data: map[string]interface{}{}}}})
+
+	data, err := json.Marshal(entry.Fields)
+	assertions.Nil(err)
+	assertions.JSONEq(`{
+		"title": {"en-US": "Hello", "de-DE": "Hallo"},
+		"views": {"en-US": 42},
+		"rating": {"en-US": 4.5},
+		"featured": {"en-US": true},
+		"date": {"en-US": "2021-03-04T10:00:00Z"},
+		"author": {"en-US": {"sys": {"type": "Link", "linkType": "Entry", "id": "jane"}}},
+		"venue": {"en-US": {"lat": 52.5, "lon": 13.4}},
+		"tags": {"en-US": ["news"]},
+		"body": {"en-US": {"nodeType": "document", "data": {}, "content": [{"nodeType": "paragraph", "data": {}, "content": []}]}}
+	}`, string(data))
+
+	// the setters and getters agree
+	date, err := entry.GetTime("date", "en-US")
+	assertions.Nil(err)
+	assertions.Equal(time.Date(2021, 3, 4, 10, 0, 0, 0, time.UTC), date)
+
+	venue, err := entry.GetLocation("venue", "en-US")
+	assertions.Nil(err)
+	assertions.Equal(Location{Lat: 52.5, Lon: 13.4}, venue)
+}
+
+func TestEntry_SetField_Delivery(t *testing.T) {
+	assertions := assert.New(t)
+
+	var entry Entry
+	assertions.Nil(json.Unmarshal([]byte(readTestData("spaces-id1-entries-nyancat.json")), &entry))
+	sys := entry.Sys
+
+	entry.SetString("name", "de-DE", "Nyan Katze")
+
+	assertions.Equal(map[string]interface{}{"en-US": "Nyan Cat", "de-DE": "Nyan Katze"}, entry.Fields["name"])
+	assertions.Equal(map[string]interface{}{"en-US": 1337.0}, entry.Fields["lives"])
+	assertions.Equal("en-US", entry.Locale)
+	assertions.Equal("", entry.Sys.Locale)
+	assertions.Equal("en-US", sys.Locale)
+}
+
+func TestLocalesService_Fallbacks(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal("/spaces/"+spaceID+"/environments/master/locales", r.URL.Path)
+		checkHeaders(r, assertions)
+
+		w.WriteHeader(200)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"total": 3,
+			"items": []*Locale{
+				{Code: "en-US", Default: true},
+				{Code: "de-DE", FallbackCode: "en-US"},
+				{Code: "de-CH", FallbackCode: "de-DE"},
+			},
+		})
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	cma = NewCMA(CMAToken, WithBaseURL(server.URL))
+
+	fallbacks, err := cma.Space(spaceID).Environment("master").Locales.Fallbacks(context.Background())
+	assertions.Nil(err)
+	assertions.Equal([]string{"de-CH", "de-DE", "en-US"}, fallbacks.Chain("de-CH"))
+	assertions.Equal([]string{"fr-FR"}, fallbacks.Chain("fr-FR"))
+
+	// cycles end the chain
+	assertions.Equal([]string{"a", "b"}, LocaleFallbacks{"a": "b", "b": "a"}.Chain("a"))
+}
@@ -2,9 +2,9 @@ package contentful
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
-	"net/http"
 	"net/url"
 	"strconv"
 )
@@ -37,45 +37,36 @@ func (entryTask *EntryTask) GetVersion() int {
 
 // List returns entry tasks collection
 func (service *EntryTasksService) List(spaceID, entryID string) *Collection {
-	path := fmt.Sprintf("/spaces/%s/environments/%s/entries/%s/tasks", spaceID, service.c.Environment, entryID)
-
-	req, err := service.c.newRequest(http.MethodGet, path, nil, nil)
-	if err != nil {
-		return &Collection{}
-	}
-
-	col := NewCollection(&CollectionOptions{})
-	col.c = service.c
-	col.req = req
-
-	return col
+	return service.c.environmentCollection(func(environment string) string {
+		return fmt.Sprintf("/spaces/%s/environments/%s/entries/%s/tasks", spaceID, environment, entryID)
+	})
 }
 
 // Get returns a single entry task
-func (service *EntryTasksService) Get(spaceID, entryID, entryTaskID string) (*EntryTask, error) {
-	path := fmt.Sprintf("/spaces/%s/environments/%s/entries/%s/tasks/%s", spaceID, service.c.Environment, entryID, entryTaskID)
+func (service *EntryTasksService) Get(ctx context.Context, spaceID, entryID, entryTaskID string) (*EntryTask, error) {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/entries/%s/tasks/%s", spaceID, service.c.environment(ctx), entryID, entryTaskID)
 	query := url.Values{}
 	method := "GET"
 
-	req, err := service.c.newRequest(method, path, query, nil)
+	req, err := service.c.newRequest(ctx, method, path, query, nil)
 	if err != nil {
 		return &EntryTask{}, err
 	}
 
 	var entryTask EntryTask
-	if ok := service.c.do(req, &entryTask); ok != nil {
+	if err := service.c.do(req, &entryTask); err != nil {
 		return nil, err
 	}
 
-	return &entryTask, err
+	return &entryTask, nil
 }
 
 // Delete the entry task
-func (service *EntryTasksService) Delete(spaceID, entryID, entryTaskID string) error {
-	path := fmt.Sprintf("/spaces/%s/environments/%s/entries/%s/tasks/%s", spaceID, service.c.Environment, entryID, entryTaskID)
+func (service *EntryTasksService) Delete(ctx context.Context, spaceID, entryID, entryTaskID string) error {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/entries/%s/tasks/%s", spaceID, service.c.environment(ctx), entryID, entryTaskID)
 	method := "DELETE"
 
-	req, err := service.c.newRequest(method, path, nil, nil)
+	req, err := service.c.newRequest(ctx, method, path, nil, nil)
 	if err != nil {
 		return err
 	}
@@ -84,7 +75,7 @@ func (service *EntryTasksService) Delete(spaceID, entryID, entryTaskID string) e
 }
 
 // Upsert updates or creates a new entry task
-func (service *EntryTasksService) Upsert(spaceID, entryID string, entryTask *EntryTask) error {
+func (service *EntryTasksService) Upsert(ctx context.Context, spaceID, entryID string, entryTask *EntryTask) error {
 	bytesArray, err := json.Marshal(entryTask)
 	if err != nil {
 		return err
@@ -94,14 +85,14 @@ func (service *EntryTasksService) Upsert(spaceID, entryID string, entryTask *Ent
 	var method string
 
 	if entryTask.Sys != nil && entryTask.Sys.CreatedAt != "" {
-		path = fmt.Sprintf("/spaces/%s/environments/%s/entries/%s/tasks/%s", spaceID, service.c.Environment, entryID, entryTask.Sys.ID)
+		path = fmt.Sprintf("/spaces/%s/environments/%s/entries/%s/tasks/%s", spaceID, service.c.environment(ctx), entryID, entryTask.Sys.ID)
 		method = "PUT"
 	} else {
-		path = fmt.Sprintf("/spaces/%s/environments/%s/entries/%s/tasks", spaceID, service.c.Environment, entryID)
+		path = fmt.Sprintf("/spaces/%s/environments/%s/entries/%s/tasks", spaceID, service.c.environment(ctx), entryID)
 		method = "POST"
 	}
 
-	req, err := service.c.newRequest(method, path, nil, bytes.NewReader(bytesArray))
+	req, err := service.c.newRequest(ctx, method, path, nil, bytes.NewReader(bytesArray))
 	if err != nil {
 		return err
 	}
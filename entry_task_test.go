@@ -1,6 +1,7 @@
 package contentful
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -30,9 +31,9 @@ func TestEntryTasksService_List(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	collection, err := cma.EntryTasks.List(spaceID, "5KsDBWseXY6QegucYAoacS").Next()
+	collection, err := cma.EntryTasks.List(spaceID, "5KsDBWseXY6QegucYAoacS").Next(context.Background())
 	assertions.Nil(err)
 	entryTasks := collection.ToEntryTask()
 	assertions.Equal(1, len(entryTasks))
@@ -59,9 +60,9 @@ func TestEntryTasksService_Get(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	entryTask, err := cma.EntryTasks.Get(spaceID, "5KsDBWseXY6QegucYAoacS", "RHfHVRz3QkAgcMq4CGg2m5")
+	entryTask, err := cma.EntryTasks.Get(context.Background(), spaceID, "5KsDBWseXY6QegucYAoacS", "RHfHVRz3QkAgcMq4CGg2m5")
 	assertions.Nil(err)
 	assertions.Equal("RHfHVRz3QkAgcMq4CGg2m5", entryTask.Sys.ID)
 }
@@ -86,10 +87,11 @@ func TestEntryTasksService_Get_2(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	_, err = cma.EntryTasks.Get(spaceID, "5KsDBWseXY6QegucYAoacS", "RHfHVRz3QkAgcMq4CGg2m5")
-	assertions.Nil(err)
+	entryTask, err := cma.EntryTasks.Get(context.Background(), spaceID, "5KsDBWseXY6QegucYAoacS", "RHfHVRz3QkAgcMq4CGg2m5")
+	assertions.NotNil(err)
+	assertions.Nil(entryTask)
 }
 
 func TestEntryTasksService_Delete(t *testing.T) {
@@ -110,12 +112,12 @@ func TestEntryTasksService_Delete(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	entryTask, err := spaceFromTestData("entry_task_1.json")
 	assertions.Nil(err)
 
-	err = cma.EntryTasks.Delete(spaceID, "5KsDBWseXY6QegucYAoacS", entryTask.Sys.ID)
+	err = cma.EntryTasks.Delete(context.Background(), spaceID, "5KsDBWseXY6QegucYAoacS", entryTask.Sys.ID)
 	assertions.Nil(err)
 }
 
@@ -143,7 +145,7 @@ func TestEntryTasksService_Upsert_Create(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	entryTask := &EntryTask{
 		Body:   "new entry task",
@@ -157,7 +159,7 @@ func TestEntryTasksService_Upsert_Create(t *testing.T) {
 		},
 	}
 
-	err := cma.EntryTasks.Upsert(spaceID, "5KsDBWseXY6QegucYAoacS", entryTask)
+	err := cma.EntryTasks.Upsert(context.Background(), spaceID, "5KsDBWseXY6QegucYAoacS", entryTask)
 	assertions.Nil(err)
 
 	assertions.Equal("new entry task", entryTask.Body)
@@ -189,7 +191,7 @@ func TestEntryTasksService_Upsert_Update(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	entryTask, err := entryTaskFromTestFile("entry_task_new.json")
 	assertions.Nil(err)
@@ -197,7 +199,7 @@ func TestEntryTasksService_Upsert_Update(t *testing.T) {
 	entryTask.Body = "Review translation"
 	entryTask.Status = "active"
 
-	err = cma.EntryTasks.Upsert(spaceID, "5KsDBWseXY6QegucYAoacS", entryTask)
+	err = cma.EntryTasks.Upsert(context.Background(), spaceID, "5KsDBWseXY6QegucYAoacS", entryTask)
 	assertions.Nil(err)
 	assertions.Equal("Review translation", entryTask.Body)
 	assertions.Equal("active", entryTask.Status)
@@ -1,6 +1,7 @@
 package contentful
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -30,9 +31,9 @@ func TestEntriesService_List(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	collection, err := cma.Entries.List(spaceID).Next()
+	collection, err := cma.Entries.List(spaceID).Next(context.Background())
 	assertions.Nil(err)
 	entry := collection.ToEntry()
 	assertions.Equal("5KsDBWseXY6QegucYAoacS", entry[0].Sys.ID)
@@ -44,7 +45,7 @@ func TestEntriesService_Get(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "GET")
-		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/entries/5KsDBWseXY6QegucYAoacS")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/master/entries/5KsDBWseXY6QegucYAoacS")
 
 		checkHeaders(r, assertions)
 
@@ -58,9 +59,9 @@ func TestEntriesService_Get(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	entry, err := cma.Entries.Get(spaceID, "5KsDBWseXY6QegucYAoacS")
+	entry, err := cma.Entries.Get(context.Background(), spaceID, "5KsDBWseXY6QegucYAoacS")
 	assertions.Nil(err)
 	assertions.Equal("5KsDBWseXY6QegucYAoacS", entry.Sys.ID)
 }
@@ -71,7 +72,7 @@ func TestEntriesService_Get_2(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "GET")
-		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/entries/5KsDBWseXY6QegucYAoacS")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/master/entries/5KsDBWseXY6QegucYAoacS")
 
 		checkHeaders(r, assertions)
 
@@ -85,10 +86,11 @@ func TestEntriesService_Get_2(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	_, err = cma.Entries.Get(spaceID, "5KsDBWseXY6QegucYAoacS")
-	assertions.Nil(err)
+	entry, err := cma.Entries.Get(context.Background(), spaceID, "5KsDBWseXY6QegucYAoacS")
+	assertions.NotNil(err)
+	assertions.Nil(entry)
 }
 
 func TestEntriesService_Delete(t *testing.T) {
@@ -97,7 +99,7 @@ func TestEntriesService_Delete(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "DELETE")
-		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/entries/4aGeQYgByqQFJtToAOh2JJ")
+		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/environments/master/entries/4aGeQYgByqQFJtToAOh2JJ")
 		checkHeaders(r, assertions)
 
 		w.WriteHeader(200)
@@ -109,14 +111,14 @@ func TestEntriesService_Delete(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	// test locale
 	entry, err := entryFromTestData("locale_1.json")
 	assertions.Nil(err)
 
 	// delete locale
-	err = cma.Entries.Delete(spaceID, entry.Sys.ID)
+	err = cma.Entries.Delete(context.Background(), spaceID, entry.Sys.ID)
 	assertions.Nil(err)
 }
 
@@ -126,7 +128,7 @@ func TestEntriesService_Upsert_Create(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "POST")
-		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/entries")
+		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/environments/master/entries")
 
 		checkHeaders(r, assertions)
 
@@ -150,7 +152,7 @@ func TestEntriesService_Upsert_Create(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	entry := &Entry{
 		Locale: "en-US",
@@ -164,7 +166,7 @@ func TestEntriesService_Upsert_Create(t *testing.T) {
 		},
 	}
 
-	err = cma.Entries.Upsert(spaceID, "hfM9RCJIk0wIm06WkEOQY", entry)
+	err = cma.Entries.Upsert(context.Background(), spaceID, "hfM9RCJIk0wIm06WkEOQY", entry)
 	assertions.Nil(err)
 }
 
@@ -174,7 +176,7 @@ func TestEntriesService_Upsert_Update(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "PUT")
-		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/entries/5KsDBWseXY6QegucYAoacS")
+		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/environments/master/entries/5KsDBWseXY6QegucYAoacS")
 
 		checkHeaders(r, assertions)
 
@@ -198,7 +200,7 @@ func TestEntriesService_Upsert_Update(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	entry, err := entryFromTestData("entry_1.json")
 	assertions.Nil(err)
@@ -206,7 +208,7 @@ func TestEntriesService_Upsert_Update(t *testing.T) {
 	body := entry.Fields["body"].(map[string]interface{})
 	body["en-US"] = "Edited text"
 
-	err = cma.Entries.Upsert(spaceID, "hfM9RCJIk0wIm06WkEOQY", entry)
+	err = cma.Entries.Upsert(context.Background(), spaceID, "hfM9RCJIk0wIm06WkEOQY", entry)
 	assertions.Nil(err)
 }
 
@@ -216,7 +218,7 @@ func TestEntriesService_Publish(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "PUT")
-		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/entries/5KsDBWseXY6QegucYAoacS/published")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/master/entries/5KsDBWseXY6QegucYAoacS/published")
 
 		checkHeaders(r, assertions)
 
@@ -230,13 +232,13 @@ func TestEntriesService_Publish(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	// test content type
 	e, err := entryFromTestData("entry_1.json")
 	assertions.Nil(err)
 
-	err = cma.Entries.Publish(spaceID, e)
+	err = cma.Entries.Publish(context.Background(), spaceID, e)
 	assertions.Nil(err)
 }
 
@@ -246,7 +248,7 @@ func TestEntriesService_Unpublish(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "DELETE")
-		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/entries/5KsDBWseXY6QegucYAoacS/published")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/master/entries/5KsDBWseXY6QegucYAoacS/published")
 
 		checkHeaders(r, assertions)
 
@@ -260,13 +262,13 @@ func TestEntriesService_Unpublish(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	// test content type
 	e, err := entryFromTestData("entry_1.json")
 	assertions.Nil(err)
 
-	err = cma.Entries.Unpublish(spaceID, e)
+	err = cma.Entries.Unpublish(context.Background(), spaceID, e)
 	assertions.Nil(err)
 }
 
@@ -276,7 +278,7 @@ func TestEntriesService_Archive(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "PUT")
-		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/entries/5KsDBWseXY6QegucYAoacS/archived")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/master/entries/5KsDBWseXY6QegucYAoacS/archived")
 
 		checkHeaders(r, assertions)
 
@@ -290,13 +292,13 @@ func TestEntriesService_Archive(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	// test content type
 	e, err := entryFromTestData("entry_1.json")
 	assertions.Nil(err)
 
-	err = cma.Entries.Archive(spaceID, e)
+	err = cma.Entries.Archive(context.Background(), spaceID, e)
 	assertions.Nil(err)
 }
 
@@ -306,7 +308,7 @@ func TestEntriesService_Unarchive(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "DELETE")
-		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/entries/5KsDBWseXY6QegucYAoacS/archived")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/master/entries/5KsDBWseXY6QegucYAoacS/archived")
 
 		checkHeaders(r, assertions)
 
@@ -320,12 +322,12 @@ func TestEntriesService_Unarchive(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	// test content type
 	e, err := entryFromTestData("entry_1.json")
 	assertions.Nil(err)
 
-	err = cma.Entries.Unarchive(spaceID, e)
+	err = cma.Entries.Unarchive(context.Background(), spaceID, e)
 	assertions.Nil(err)
 }
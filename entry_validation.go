@@ -0,0 +1,527 @@
+package contentful
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// ErrLocalesRequired is returned when an entry is validated without the
+// locales of its environment
+var ErrLocalesRequired = errors.New("contentful: the locales of the environment are required to validate an entry")
+
+// EntryValidationError lists the errors of an entry which does not satisfy
+// its content type. The errors have the shape of the details of a
+// ValidationFailedError returned by the api, with the path of the invalid
+// value, eg. ["fields", "title", "en-US"], and for array items its index.
+type EntryValidationError struct {
+	Errors []*ErrorDetail
+}
+
+func (e EntryValidationError) Error() string {
+	msg := strings.Builder{}
+
+	for _, err := range e.Errors {
+		path := []string{}
+		if elements, ok := err.Path.([]interface{}); ok {
+			for _, element := range elements {
+				path = append(path, fmt.Sprint(element))
+			}
+		}
+
+		msg.WriteString(fmt.Sprintf("%s: %s\n", strings.Join(path, "."), err.Details))
+	}
+
+	return msg.String()
+}
+
+// ValidateEntry checks the fields of an entry against the content type
+// before it is sent to the api, and returns an EntryValidationError listing
+// every violation. Localized fields are checked in each of the locales and
+// the other fields in the default locale. Required fields may be empty in
+// optional locales.
+//
+// Required fields, field types, size, range, regexp, prohibited regexp,
+// predefined values and date ranges are checked on the values, and the validations of the items
+// of array fields on each item. The content type of a linked entry, and the
+// mime type group, image dimensions and file size of a linked asset are only
+// known for links resolved to *Entry and *Asset, eg. by
+// Collection.ResolveLinks; other links are only checked for their link type.
+// Unique values can not be checked locally. The locales of the environment
+// are required, ErrLocalesRequired is returned without them.
+func (ct *ContentType) ValidateEntry(entry *Entry, locales []*Locale) error {
+	if len(locales) == 0 {
+		return ErrLocalesRequired
+	}
+
+	v := &entryValidator{}
+	known := map[string]bool{}
+
+	for _, field := range ct.Fields {
+		if field.Deleted {
+			continue
+		}
+
+		known[field.ID] = true
+		localized, _ := entry.Fields[field.ID].(map[string]interface{})
+
+		fieldLocales := locales
+		if !field.Localized {
+			fieldLocales = defaultLocale(locales)
+		}
+
+		for _, locale := range fieldLocales {
+			path := []interface{}{"fields", field.ID, locale.Code}
+
+			value := localized[locale.Code]
+			if empty(value) {
+				if field.Required && !locale.Optional {
+					v.fail(path, "required", fmt.Sprintf("The property %q is required here", field.ID), nil)
+				}
+
+				continue
+			}
+
+			if !v.validate(path, field.Type, field.LinkType, field.Validations, value) {
+				continue
+			}
+
+			if field.Type == FieldTypeArray && field.Items != nil {
+				items, _ := asSlice(value)
+				for i, item := range items {
+					itemPath := append(append([]interface{}{}, path...), i)
+					v.validate(itemPath, field.Items.Type, field.Items.LinkType, field.Items.Validations, item)
+				}
+			}
+		}
+	}
+
+	unknown := []string{}
+	for id := range entry.Fields {
+		if !known[id] {
+			unknown = append(unknown, id)
+		}
+	}
+	sort.Strings(unknown)
+
+	for _, id := range unknown {
+		v.fail([]interface{}{"fields", id}, "unknown", fmt.Sprintf("The property %q is not defined in the content type", id), nil)
+	}
+
+	if len(v.errors) == 0 {
+		return nil
+	}
+
+	return EntryValidationError{Errors: v.errors}
+}
+
+type entryValidator struct {
+	errors []*ErrorDetail
+}
+
+func (v *entryValidator) fail(path []interface{}, name, details string, value interface{}) {
+	v.errors = append(v.errors, &ErrorDetail{Name: name, Path: path, Details: details, Value: value})
+}
+
+// validate checks the type of a value then its validations, and reports
+// whether the value has the expected type
+func (v *entryValidator) validate(path []interface{}, fieldType, linkType string, validations []FieldValidation, value interface{}) bool {
+	if !hasFieldType(value, fieldType, linkType) {
+		expected := fieldType
+		if fieldType == FieldTypeLink {
+			expected = "Link to " + linkType
+		}
+
+		v.fail(path, "type", fmt.Sprintf("The type of the value is incorrect, expected type: %s", expected), value)
+
+		return false
+	}
+
+	for _, validation := range validations {
+		// validations parsed from json are values, those built in code are often pointers
+		rv := reflect.ValueOf(validation)
+		if rv.Kind() == reflect.Ptr {
+			if rv.IsNil() {
+				continue
+			}
+			validation = rv.Elem().Interface().(FieldValidation)
+		}
+
+		switch validation := validation.(type) {
+		case FieldValidationSize:
+			if size, ok := valueSize(value); ok && outOfBounds(validation.Size, float64(size)) {
+				v.fail(path, "size", message(validation.ErrorMessage, boundsDetails("Size", validation.Size)), value)
+			}
+
+		case FieldValidationRange:
+			if number, ok := asNumber(value); ok && outOfBounds(validation.Range, number) {
+				v.fail(path, "range", message(validation.ErrorMessage, boundsDetails("Value", validation.Range)), value)
+			}
+
+		case FieldValidationRegex:
+			if s, ok := value.(string); ok && validation.Regex != nil {
+				re, err := compileRegex(validation.Regex)
+				// patterns which are not supported by go are left to the api
+				if err == nil && !re.MatchString(s) {
+					v.fail(path, "regexp", message(validation.ErrorMessage, "Does not match the regular expression"), value)
+				}
+			}
+
+		case FieldValidationProhibitRegex:
+			if s, ok := value.(string); ok && validation.Regex != nil {
+				re, err := compileRegex(validation.Regex)
+				if err == nil && re.MatchString(s) {
+					v.fail(path, "prohibitRegexp", message(validation.ErrorMessage, "Matches the prohibited regular expression"), value)
+				}
+			}
+
+		case FieldValidationPredefinedValues:
+			if !isPredefined(value, validation.In) {
+				v.fail(path, "in", message(validation.ErrorMessage, "Value must be one of expected values"), value)
+			}
+
+		case FieldValidationDate:
+			s, ok := value.(string)
+			if !ok || validation.Range == nil {
+				continue
+			}
+
+			if date, err := ParseDate(s); err == nil {
+				before := !validation.Range.Min.IsZero() && date.Before(validation.Range.Min)
+				after := !validation.Range.Max.IsZero() && date.After(validation.Range.Max)
+				if before || after {
+					v.fail(path, "dateRange", message(validation.ErrorMessage, dateRangeDetails(validation.Range)), value)
+				}
+			}
+
+		case FieldValidationLink:
+			if linked, ok := value.(*Entry); ok && len(validation.LinkContentType) > 0 {
+				contentType := ""
+				if linked.Sys != nil && linked.Sys.ContentType != nil && linked.Sys.ContentType.Sys != nil {
+					contentType = linked.Sys.ContentType.Sys.ID
+				}
+
+				if !contains(validation.LinkContentType, contentType) {
+					details := fmt.Sprintf("Linked Entry's content type must be one of %s", strings.Join(validation.LinkContentType, ", "))
+					v.fail(path, "linkContentType", details, value)
+				}
+			}
+
+		case FieldValidationMimeType:
+			for _, file := range assetFiles(value) {
+				if !inMimeTypeGroups(file.ContentType, validation.MimeTypes) {
+					details := fmt.Sprintf("Linked Asset's mime type must be one of %s", strings.Join(validation.MimeTypes, ", "))
+					v.fail(path, "linkMimetypeGroup", details, value)
+					break
+				}
+			}
+
+		case FieldValidationDimension:
+			for _, file := range assetFiles(value) {
+				if file.Details == nil || file.Details.Image == nil {
+					continue
+				}
+
+				image := file.Details.Image
+				if outOfBounds(validation.Width, float64(image.Width)) || outOfBounds(validation.Height, float64(image.Height)) {
+					details := message(validation.ErrorMessage, "Linked Asset's image dimensions are out of the allowed range")
+					v.fail(path, "assetImageDimensions", details, value)
+					break
+				}
+			}
+
+		case FieldValidationFileSize:
+			for _, file := range assetFiles(value) {
+				if file.Details != nil && outOfBounds(validation.Size, float64(file.Details.Size)) {
+					v.fail(path, "assetFileSize", message(validation.ErrorMessage, boundsDetails("Linked Asset's file size", validation.Size)), value)
+					break
+				}
+			}
+		}
+	}
+
+	return true
+}
+
+// empty reports whether a value is missing, which fails required fields
+func empty(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+
+	items, ok := asSlice(value)
+
+	return ok && len(items) == 0
+}
+
+// hasFieldType reports whether a value, decoded from json or set in code,
+// can be stored in a field of the given type
+func hasFieldType(value interface{}, fieldType, linkType string) bool {
+	switch fieldType {
+	case FieldTypeText, FieldTypeSymbol:
+		_, ok := value.(string)
+		return ok
+
+	case FieldTypeInteger:
+		number, ok := asNumber(value)
+		return ok && number == float64(int64(number))
+
+	case FieldTypeNumber:
+		_, ok := asNumber(value)
+		return ok
+
+	case FieldTypeBoolean:
+		_, ok := value.(bool)
+		return ok
+
+	case FieldTypeDate:
+		s, ok := value.(string)
+		if !ok {
+			return false
+		}
+		_, err := ParseDate(s)
+		return err == nil
+
+	case FieldTypeLocation:
+		switch location := value.(type) {
+		case Location, *Location:
+			return true
+		case map[string]interface{}:
+			_, lat := asNumber(location["lat"])
+			_, lon := asNumber(location["lon"])
+			return lat && lon
+		}
+		return false
+
+	case FieldTypeArray:
+		_, ok := asSlice(value)
+		return ok
+
+	case FieldTypeLink:
+		switch link := value.(type) {
+		case *Entry:
+			return linkType == "Entry"
+		case *Asset:
+			return linkType == "Asset"
+		case map[string]interface{}:
+			actual, _, ok := parseLink(link)
+			return ok && actual == linkType
+		}
+		return false
+	}
+
+	// Object and RichText
+	return true
+}
+
+// asNumber returns a number decoded from json or set as any go number
+func asNumber(value interface{}) (float64, bool) {
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(rv.Uint()), true
+	}
+
+	return 0, false
+}
+
+// asSlice returns the items of a slice decoded from json or set in code, eg. []string
+func asSlice(value interface{}) ([]interface{}, bool) {
+	if items, ok := value.([]interface{}); ok {
+		return items, true
+	}
+
+	rv := reflect.ValueOf(value)
+	if rv.Kind() != reflect.Slice {
+		return nil, false
+	}
+
+	items := make([]interface{}, rv.Len())
+	for i := range items {
+		items[i] = rv.Index(i).Interface()
+	}
+
+	return items, true
+}
+
+// valueSize returns the length of a text, the number of items of an array
+// or the number of properties of an object
+func valueSize(value interface{}) (int, bool) {
+	if s, ok := value.(string); ok {
+		return utf8.RuneCountInString(s), true
+	}
+
+	if items, ok := asSlice(value); ok {
+		return len(items), true
+	}
+
+	if object, ok := value.(map[string]interface{}); ok {
+		return len(object), true
+	}
+
+	return 0, false
+}
+
+// outOfBounds reports whether a value is outside of the bounds which are set
+func outOfBounds(bounds *MinMax, value float64) bool {
+	if bounds == nil {
+		return false
+	}
+
+	return (bounds.HasMin() && value < bounds.Min) || (bounds.HasMax() && value > bounds.Max)
+}
+
+func boundsDetails(subject string, bounds *MinMax) string {
+	switch {
+	case bounds.HasMin() && bounds.HasMax():
+		return fmt.Sprintf("%s must be between %v and %v", subject, bounds.Min, bounds.Max)
+	case bounds.HasMin():
+		return fmt.Sprintf("%s must be at least %v", subject, bounds.Min)
+	default:
+		return fmt.Sprintf("%s must be at most %v", subject, bounds.Max)
+	}
+}
+
+func dateRangeDetails(bounds *DateMinMax) string {
+	const layout = "2006-01-02T15:04:05"
+
+	switch {
+	case !bounds.Min.IsZero() && !bounds.Max.IsZero():
+		return fmt.Sprintf("Date must be between %s and %s", bounds.Min.Format(layout), bounds.Max.Format(layout))
+	case !bounds.Min.IsZero():
+		return fmt.Sprintf("Date must be after %s", bounds.Min.Format(layout))
+	default:
+		return fmt.Sprintf("Date must be before %s", bounds.Max.Format(layout))
+	}
+}
+
+// message returns the custom message of a validation, which the api returns
+// as details, or the default details
+func message(custom, details string) string {
+	if custom != "" {
+		return custom
+	}
+
+	return details
+}
+
+// compileRegex compiles the pattern of a regexp validation, with the
+// javascript flags i, m and s; the global flag is meaningless for a match
+func compileRegex(regex *Regex) (*regexp.Regexp, error) {
+	flags := ""
+	for _, flag := range regex.Flags {
+		if strings.ContainsRune("ims", flag) {
+			flags += string(flag)
+		}
+	}
+
+	pattern := regex.Pattern
+	if flags != "" {
+		pattern = "(?" + flags + ")" + pattern
+	}
+
+	return regexp.Compile(pattern)
+}
+
+// isPredefined reports whether a value is one of the predefined values,
+// numbers being compared whatever their go type
+func isPredefined(value interface{}, in []interface{}) bool {
+	number, isNumber := asNumber(value)
+
+	for _, predefined := range in {
+		if expected, ok := asNumber(predefined); ok && isNumber {
+			if expected == number {
+				return true
+			}
+			continue
+		}
+
+		if reflect.DeepEqual(predefined, value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// assetFiles returns the files of a resolved asset, in all locales
+func assetFiles(value interface{}) []*File {
+	asset, ok := value.(*Asset)
+	if !ok || asset.Fields == nil {
+		return nil
+	}
+
+	files := []*File{}
+	for _, locale := range sortedKeys(asset.Fields.File, nil) {
+		if file := asset.Fields.File[locale]; file != nil {
+			files = append(files, file)
+		}
+	}
+
+	return files
+}
+
+// mimeTypeGroups are the mime types of the groups of a linkMimetypeGroup
+// validation, a trailing * matching any subtype
+var mimeTypeGroups = map[string][]string{
+	MimeTypeAttachment: {"*"},
+	MimeTypePlainText:  {"text/plain"},
+	MimeTypeImage:      {"image/*"},
+	MimeTypeAudio:      {"audio/*"},
+	MimeTypeVideo:      {"video/*"},
+	MimeTypeRichText: {
+		"application/msword", "application/rtf", "text/rtf", "application/vnd.oasis.opendocument.text",
+		"application/vnd.openxmlformats-officedocument.wordprocessingml.*", "application/vnd.apple.pages",
+	},
+	MimeTypePresentation: {
+		"application/vnd.ms-powerpoint", "application/vnd.oasis.opendocument.presentation",
+		"application/vnd.openxmlformats-officedocument.presentationml.*", "application/vnd.apple.keynote",
+	},
+	MimeTypeSpreadSheet: {
+		"application/vnd.ms-excel", "application/vnd.oasis.opendocument.spreadsheet", "text/csv",
+		"application/vnd.openxmlformats-officedocument.spreadsheetml.*", "application/vnd.apple.numbers",
+	},
+	MimeTypePDF: {"application/pdf"},
+	MimeTypeArchive: {
+		"application/zip", "application/x-zip-compressed", "application/x-tar", "application/gzip",
+		"application/x-gzip", "application/x-7z-compressed", "application/x-rar-compressed", "application/vnd.rar",
+	},
+	MimeTypeCode: {
+		"application/json", "application/javascript", "text/javascript", "text/css", "text/x-*", "application/x-sh",
+	},
+	MimeTypeMarkup: {"text/html", "application/xml", "text/xml", "application/xhtml+xml", "text/markdown"},
+}
+
+// inMimeTypeGroups reports whether a mime type belongs to one of the groups
+func inMimeTypeGroups(mimeType string, groups []string) bool {
+	mimeType, _, _ = strings.Cut(strings.ToLower(mimeType), ";")
+	mimeType = strings.TrimSpace(mimeType)
+
+	for _, group := range groups {
+		for _, pattern := range mimeTypeGroups[group] {
+			if pattern == mimeType || (strings.HasSuffix(pattern, "*") && strings.HasPrefix(mimeType, strings.TrimSuffix(pattern, "*"))) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
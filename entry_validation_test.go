@@ -0,0 +1,127 @@
+package contentful
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testLink(linkType, id string) map[string]interface{} {
+	return map[string]interface{}{"sys": map[string]interface{}{"type": "Link", "linkType": linkType, "id": id}}
+}
+
+func TestContentType_ValidateEntry(t *testing.T) {
+	assertions := assert.New(t)
+
+	var ct ContentType
+	assertions.Nil(json.Unmarshal([]byte(readTestData("content_type_with_validations.json")), &ct))
+
+	image := &Asset{
+		Sys: &Sys{ID: "image"},
+		Fields: &AssetFields{File: map[string]*File{
+			"en-US": {ContentType: "image/png", Details: &FileDetails{Size: 1000, Image: &ImageFields{Width: 100, Height: 150}}},
+		}},
+	}
+
+	entry := &Entry{Fields: map[string]interface{}{
+		"HbvLK9kzF91K9byY": map[string]interface{}{"en-US": "test"},
+		"fYw4yVZHkAIYe4JB": map[string]interface{}{"en-US": 25},
+		"e7xPyLeknXzWHCAJ": map[string]interface{}{"en-US": 6.0},
+		"FHjBxcapHJXJdlkE": map[string]interface{}{"en-US": "2017-04-01"},
+		"oX4Cv7v26o64YNXX": map[string]interface{}{"en-US": map[string]interface{}{"lat": 52.5, "lon": 13.4}},
+		"yW00FKvHHYGqNIgW": map[string]interface{}{"en-US": image},
+		"Aomif1Mekh2BN2vo": map[string]interface{}{"en-US": []interface{}{testLink("Asset", "image")}},
+		"kNXc1q71qKHyaqWi": map[string]interface{}{"en-US": "yes"},
+		"gAh5d0vzBG5XNYQL": map[string]interface{}{"en-US": map[string]interface{}{"a": 1}},
+		"LGcZi0zOZ5oVbZG1": map[string]interface{}{"en-US": &Entry{Sys: &Sys{ID: "other", ContentType: &ContentType{Sys: &Sys{ID: "page"}}}}},
+		"MB0nzivjE8vyIgCi": map[string]interface{}{"en-US": []interface{}{testLink("Entry", "a"), testLink("Asset", "b")}},
+		"extra":            map[string]interface{}{"en-US": "value"},
+	}}
+
+	err := ct.ValidateEntry(entry, []*Locale{{Code: "en-US", Default: true}})
+
+	var validationErr EntryValidationError
+	assertions.True(errors.As(err, &validationErr))
+
+	var failures []string
+	for _, detail := range validationErr.Errors {
+		failures = append(failures, fmt.Sprintf("%v %s", detail.Path, detail.Name))
+	}
+
+	assertions.Equal([]string{
+		"[fields HbvLK9kzF91K9byY en-US] size",
+		"[fields HbvLK9kzF91K9byY en-US] regexp",
+		"[fields y5NKeXJImJidOLOF en-US] required",
+		"[fields fYw4yVZHkAIYe4JB en-US] range",
+		"[fields fYw4yVZHkAIYe4JB en-US] in",
+		"[fields FHjBxcapHJXJdlkE en-US] dateRange",
+		"[fields yW00FKvHHYGqNIgW en-US] assetFileSize",
+		"[fields Aomif1Mekh2BN2vo en-US] size",
+		"[fields kNXc1q71qKHyaqWi en-US] type",
+		"[fields gAh5d0vzBG5XNYQL en-US] size",
+		"[fields LGcZi0zOZ5oVbZG1 en-US] linkContentType",
+		"[fields MB0nzivjE8vyIgCi en-US 1] type",
+		"[fields extra] unknown",
+	}, failures)
+
+	// the custom message of a validation is the details of its error
+	assertions.Equal("text-short range error message", validationErr.Errors[0].Details)
+	assertions.Equal("test", validationErr.Errors[0].Value)
+	assertions.Equal(`The property "y5NKeXJImJidOLOF" is required here`, validationErr.Errors[2].Details)
+	assertions.Contains(err.Error(), "fields.fYw4yVZHkAIYe4JB.en-US: number-integer range error message\n")
+}
+
+func TestContentType_ValidateEntry_Locales(t *testing.T) {
+	assertions := assert.New(t)
+
+	ct := &ContentType{
+		Fields: []*Field{
+			{ID: "title", Type: FieldTypeSymbol, Required: true, Localized: true, Validations: []FieldValidation{
+				&FieldValidationSize{Size: &MinMax{Max: 10}},
+				&FieldValidationRegex{Regex: &Regex{Pattern: "^[a-z]+$", Flags: "i"}},
+			}},
+			{ID: "slug", Type: FieldTypeSymbol, Required: true},
+			{ID: "tags", Type: FieldTypeArray, Items: &FieldTypeArrayItem{Type: FieldTypeSymbol, Validations: []FieldValidation{
+				&FieldValidationPredefinedValues{In: []interface{}{"news", "tech"}},
+			}}},
+			{ID: "image", Type: FieldTypeLink, LinkType: "Asset", Validations: []FieldValidation{
+				&FieldValidationMimeType{MimeTypes: []string{MimeTypeImage}},
+			}},
+		},
+	}
+
+	locales := []*Locale{
+		{Code: "en-US", Default: true},
+		{Code: "de-DE"},
+		{Code: "fr-FR", Optional: true},
+	}
+
+	entry := &Entry{Fields: map[string]interface{}{
+		"title": map[string]interface{}{"en-US": "Hello", "de-DE": "Hallo"},
+		"slug":  map[string]interface{}{"en-US": "hello"},
+		"tags":  map[string]interface{}{"en-US": []string{"news", "tech"}},
+		"image": map[string]interface{}{"en-US": &Asset{Fields: &AssetFields{File: map[string]*File{"en-US": {ContentType: "image/jpeg"}}}}},
+	}}
+
+	assertions.Equal(ErrLocalesRequired, ct.ValidateEntry(entry, nil))
+
+	// the optional locale may be empty, and non localized fields are only set in the default locale
+	assertions.Nil(ct.ValidateEntry(entry, locales))
+
+	entry.Fields["title"] = map[string]interface{}{"en-US": "Hello world!"}
+	entry.Fields["tags"] = map[string]interface{}{"en-US": []string{"news", "sports"}}
+	entry.Fields["image"] = map[string]interface{}{"en-US": &Asset{Fields: &AssetFields{File: map[string]*File{"en-US": {ContentType: "application/pdf"}}}}}
+
+	var validationErr EntryValidationError
+	assertions.True(errors.As(ct.ValidateEntry(entry, locales), &validationErr))
+	assertions.Equal([]*ErrorDetail{
+		{Name: "size", Path: []interface{}{"fields", "title", "en-US"}, Details: "Size must be at most 10", Value: "Hello world!"},
+		{Name: "regexp", Path: []interface{}{"fields", "title", "en-US"}, Details: "Does not match the regular expression", Value: "Hello world!"},
+		{Name: "required", Path: []interface{}{"fields", "title", "de-DE"}, Details: `The property "title" is required here`},
+		{Name: "in", Path: []interface{}{"fields", "tags", "en-US", 1}, Details: "Value must be one of expected values", Value: "sports"},
+		{Name: "linkMimetypeGroup", Path: []interface{}{"fields", "image", "en-US"}, Details: "Linked Asset's mime type must be one of image", Value: entry.Fields["image"].(map[string]interface{})["en-US"]},
+	}, validationErr.Errors)
+}
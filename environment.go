@@ -2,9 +2,25 @@ package contentful
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"strconv"
+	"time"
+)
+
+const (
+	// EnvironmentStatusQueued is the status of an environment being created
+	EnvironmentStatusQueued = "queued"
+
+	// EnvironmentStatusReady is the status of an environment which can be used
+	EnvironmentStatusReady = "ready"
+
+	// EnvironmentStatusFailed is the status of an environment whose creation failed
+	EnvironmentStatusFailed = "failed"
+
+	// DefaultEnvironmentPollInterval is the interval between two polls of WaitReady
+	DefaultEnvironmentPollInterval = time.Second
 )
 
 // EnvironmentsService service
@@ -14,6 +30,38 @@ type EnvironmentsService service
 type Environment struct {
 	Sys  *Sys   `json:"sys"`
 	Name string `json:"name"`
+
+	// Status is the status of the environment, read from the link in sys
+	Status string `json:"-"`
+
+	// SourceEnvironmentID is the environment cloned by Upsert when it creates
+	// the environment, master when empty
+	SourceEnvironmentID string `json:"-"`
+}
+
+// UnmarshalJSON reads the status link of the environment
+func (e *Environment) UnmarshalJSON(data []byte) error {
+	type environment Environment
+	if err := json.Unmarshal(data, (*environment)(e)); err != nil {
+		return err
+	}
+
+	var payload struct {
+		Sys struct {
+			Status *struct {
+				Sys *Sys `json:"sys"`
+			} `json:"status"`
+		} `json:"sys"`
+	}
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return err
+	}
+
+	if status := payload.Sys.Status; status != nil && status.Sys != nil {
+		e.Status = status.Sys.ID
+	}
+
+	return nil
 }
 
 // GetVersion returns entity version
@@ -31,7 +79,7 @@ func (service *EnvironmentsService) List(spaceID string) *Collection {
 	path := fmt.Sprintf("/spaces/%s/environments", spaceID)
 	method := "GET"
 
-	req, err := service.c.newRequest(method, path, nil, nil)
+	req, err := service.c.newRequest(context.Background(), method, path, nil, nil)
 	if err != nil {
 		return nil
 	}
@@ -44,11 +92,11 @@ func (service *EnvironmentsService) List(spaceID string) *Collection {
 }
 
 // Get returns a single environment entity
-func (service *EnvironmentsService) Get(spaceID, environmentID string) (*Environment, error) {
+func (service *EnvironmentsService) Get(ctx context.Context, spaceID, environmentID string) (*Environment, error) {
 	path := fmt.Sprintf("/spaces/%s/environments/%s", spaceID, environmentID)
 	method := "GET"
 
-	req, err := service.c.newRequest(method, path, nil, nil)
+	req, err := service.c.newRequest(ctx, method, path, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -62,7 +110,7 @@ func (service *EnvironmentsService) Get(spaceID, environmentID string) (*Environ
 }
 
 // Upsert updates or creates a new environment
-func (service *EnvironmentsService) Upsert(spaceID string, e *Environment) error {
+func (service *EnvironmentsService) Upsert(ctx context.Context, spaceID string, e *Environment) error {
 	bytesArray, err := json.Marshal(e)
 	if err != nil {
 		return err
@@ -79,22 +127,51 @@ func (service *EnvironmentsService) Upsert(spaceID string, e *Environment) error
 		method = "PUT"
 	}
 
-	req, err := service.c.newRequest(method, path, nil, bytes.NewReader(bytesArray))
+	req, err := service.c.newRequest(ctx, method, path, nil, bytes.NewReader(bytesArray))
 	if err != nil {
 		return err
 	}
 
 	req.Header.Set("X-Contentful-Version", strconv.Itoa(e.GetVersion()))
+	if e.SourceEnvironmentID != "" {
+		req.Header.Set("X-Contentful-Source-Environment", e.SourceEnvironmentID)
+	}
 
 	return service.c.do(req, e)
 }
 
+// WaitReady polls the environment every interval, DefaultEnvironmentPollInterval
+// when zero, until its creation, or the clone of its source environment, is done
+func (service *EnvironmentsService) WaitReady(ctx context.Context, spaceID, environmentID string, interval time.Duration) (*Environment, error) {
+	if interval <= 0 {
+		interval = DefaultEnvironmentPollInterval
+	}
+
+	for {
+		environment, err := service.Get(ctx, spaceID, environmentID)
+		if err != nil {
+			return nil, err
+		}
+
+		switch environment.Status {
+		case EnvironmentStatusReady:
+			return environment, nil
+		case EnvironmentStatusFailed:
+			return environment, fmt.Errorf("contentful: creation of environment %s failed", environmentID)
+		}
+
+		if err := sleep(ctx, interval); err != nil {
+			return environment, err
+		}
+	}
+}
+
 // Delete the environment
-func (service *EnvironmentsService) Delete(spaceID string, e *Environment) error {
+func (service *EnvironmentsService) Delete(ctx context.Context, spaceID string, e *Environment) error {
 	path := fmt.Sprintf("/spaces/%s/environments/%s", spaceID, e.Sys.ID)
 	method := "DELETE"
 
-	req, err := service.c.newRequest(method, path, nil, nil)
+	req, err := service.c.newRequest(ctx, method, path, nil, nil)
 	if err != nil {
 		return err
 	}
@@ -1,6 +1,7 @@
 package contentful
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -30,9 +31,9 @@ func TestEnvironmentAliasesServices_List(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	collection, err := cma.EnvironmentAliases.List(spaceID).Next()
+	collection, err := cma.EnvironmentAliases.List(spaceID).Next(context.Background())
 	assertions.Nil(err)
 	environmentAlias := collection.ToEnvironmentAlias()
 	assertions.Equal(1, len(environmentAlias))
@@ -60,9 +61,9 @@ func TestEnvironmentAliasesServices_Get(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	environmentAlias, err := cma.EnvironmentAliases.Get(spaceID, "master")
+	environmentAlias, err := cma.EnvironmentAliases.Get(context.Background(), spaceID, "master")
 	assertions.Nil(err)
 	assertions.Equal("master-18-3-2020", environmentAlias.Alias.Sys.ID)
 }
@@ -88,9 +89,9 @@ func TestEnvironmentAliasesServices_Get_2(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	_, err = cma.EnvironmentAliases.Get(spaceID, "master")
+	_, err = cma.EnvironmentAliases.Get(context.Background(), spaceID, "master")
 	assertions.NotNil(err)
 }
 
@@ -119,13 +120,13 @@ func TestEnvironmentAliasesService_Update(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	environmentAlias, err := environmentAliasFromTestData("environment_alias_1.json")
 	assertions.Nil(err)
 
 	environmentAlias.Alias.Sys.ID = "staging"
 
-	err = cma.EnvironmentAliases.Update(spaceID, environmentAlias)
+	err = cma.EnvironmentAliases.Update(context.Background(), spaceID, environmentAlias)
 	assertions.Nil(err)
 }
@@ -1,11 +1,14 @@
 package contentful
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -30,9 +33,9 @@ func TestEnvironmentsService_List(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	collection, err := cma.Environments.List(spaceID).Next()
+	collection, err := cma.Environments.List(spaceID).Next(context.Background())
 	assertions.Nil(err)
 	environment := collection.ToEnvironment()
 	assertions.Equal(1, len(environment))
@@ -59,9 +62,9 @@ func TestEnvironmentsService_Get(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	environment, err := cma.Environments.Get(spaceID, "staging")
+	environment, err := cma.Environments.Get(context.Background(), spaceID, "staging")
 	assertions.Nil(err)
 	assertions.Equal("staging", environment.Name)
 }
@@ -87,9 +90,9 @@ func TestEnvironmentsService_Get_2(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	_, err = cma.Environments.Get(spaceID, "master")
+	_, err = cma.Environments.Get(context.Background(), spaceID, "master")
 	assertions.NotNil(err)
 }
 
@@ -118,16 +121,58 @@ func TestEnvironmentsService_Upsert_Create(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	environment := &Environment{
 		Name: "staging",
 	}
 
-	err = cma.Environments.Upsert(spaceID, environment)
+	err = cma.Environments.Upsert(context.Background(), spaceID, environment)
 	assertions.Nil(err)
 }
 
+func TestEnvironmentsService_Upsert_Clone(t *testing.T) {
+	assertions := assert.New(t)
+
+	polls := 0
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/staging")
+		checkHeaders(r, assertions)
+
+		w.WriteHeader(200)
+		if r.Method == "PUT" {
+			assertions.Equal("master", r.Header.Get("X-Contentful-Source-Environment"))
+			_, _ = fmt.Fprintln(w, strings.Replace(readTestData("environment_1.json"), `"ready"`, `"queued"`, 1))
+			return
+		}
+
+		polls++
+		if polls < 3 {
+			_, _ = fmt.Fprintln(w, strings.Replace(readTestData("environment_1.json"), `"ready"`, `"queued"`, 1))
+			return
+		}
+
+		_, _ = fmt.Fprintln(w, readTestData("environment_1.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken, WithBaseURL(server.URL))
+
+	environment := &Environment{Name: "staging", SourceEnvironmentID: "master"}
+	err := cma.Environments.Upsert(context.Background(), spaceID, environment)
+	assertions.Nil(err)
+	assertions.Equal(EnvironmentStatusQueued, environment.Status)
+
+	environment, err = cma.Space(spaceID).Environments.WaitReady(context.Background(), "staging", time.Millisecond)
+	assertions.Nil(err)
+	assertions.Equal(3, polls)
+	assertions.Equal(EnvironmentStatusReady, environment.Status)
+}
+
 func TestEnvironmentsService_Upsert_Update(t *testing.T) {
 	var err error
 	assertions := assert.New(t)
@@ -153,14 +198,14 @@ func TestEnvironmentsService_Upsert_Update(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	environment, err := environmentFromTestData("environment_1.json")
 	assertions.Nil(err)
 
 	environment.Name = "modified-name"
 
-	err = cma.Environments.Upsert(spaceID, environment)
+	err = cma.Environments.Upsert(context.Background(), spaceID, environment)
 	assertions.Nil(err)
 }
 
@@ -182,13 +227,13 @@ func TestEnvironmentsService_Delete(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	// test environment
 	environment, err := environmentFromTestData("environment_1.json")
 	assertions.Nil(err)
 
 	// delete environment
-	err = cma.Environments.Delete(spaceID, environment)
+	err = cma.Environments.Delete(context.Background(), spaceID, environment)
 	assertions.Nil(err)
 }
@@ -1,6 +1,7 @@
 package contentful
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -24,10 +25,10 @@ func TestNotFoundError_Error(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	// test space
-	_, err = cma.Spaces.Get("unknown-space-id")
+	_, err = cma.Spaces.Get(context.Background(), "unknown-space-id")
 	assertions.NotNil(err)
 	_, ok := err.(NotFoundError)
 	assertions.Equal(true, ok)
@@ -55,11 +56,11 @@ func TestRateLimitExceededError_Error(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	// test space
 	space := &Space{Name: "test-space"}
-	err = cma.Spaces.Upsert(space)
+	err = cma.Spaces.Upsert(context.Background(), space)
 	assertions.NotNil(err)
 	_, ok := err.(RateLimitExceededError)
 	assertions.Equal(true, ok)
@@ -87,11 +88,11 @@ func TestAccessTokenInvalidError_Error(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	// test space
 	space := &Space{Name: "test-space"}
-	err = cma.Spaces.Upsert(space)
+	err = cma.Spaces.Upsert(context.Background(), space)
 	assertions.NotNil(err)
 	_, ok := err.(AccessTokenInvalidError)
 	assertions.Equal(true, ok)
@@ -0,0 +1,233 @@
+package contentful
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ExportOptions configure an Exporter, the Skip options match the flags of contentful-export
+type ExportOptions struct {
+	// SkipContentModel skips content types and editor interfaces
+	SkipContentModel bool
+
+	// SkipEditorInterfaces skips editor interfaces
+	SkipEditorInterfaces bool
+
+	// SkipContent skips entries and assets
+	SkipContent bool
+
+	// SkipRoles skips roles
+	SkipRoles bool
+
+	// SkipWebhooks skips webhooks
+	SkipWebhooks bool
+
+	// AssetsDir is the directory the asset files are downloaded into, under
+	// their host and path like contentful-export does. Files are not
+	// downloaded when empty.
+	AssetsDir string
+}
+
+// Exporter writes the content model and the content of an environment in the
+// JSON layout of contentful-export
+type Exporter struct {
+	client  *Client
+	spaceID string
+	options ExportOptions
+}
+
+// exportSection is a top level array of an export
+type exportSection struct {
+	key        string
+	skip       bool
+	collection func() *Collection
+}
+
+// NewExporter returns an exporter of the space and the environment of the client
+func NewExporter(c *Client, spaceID string, options *ExportOptions) *Exporter {
+	exporter := &Exporter{client: c, spaceID: spaceID}
+	if options != nil {
+		exporter.options = *options
+	}
+
+	return exporter
+}
+
+// Export writes the export to w while walking the collections page by page,
+// so that the space does not need to fit in memory. Items are written as
+// returned by the api, nothing is dropped by decoding them into models.
+func (exporter *Exporter) Export(ctx context.Context, w io.Writer) error {
+	c := exporter.client
+	spaceID := exporter.spaceID
+	options := exporter.options
+
+	sections := []exportSection{
+		{"contentTypes", options.SkipContentModel, func() *Collection { return c.ContentTypes.List(spaceID) }},
+		{"editorInterfaces", options.SkipContentModel || options.SkipEditorInterfaces, func() *Collection { return c.EditorInterfaces.List(spaceID) }},
+		{"entries", options.SkipContent, func() *Collection { return c.Entries.List(spaceID).WithKeysetPagination() }},
+		{"assets", options.SkipContent, func() *Collection { return c.Assets.List(spaceID).WithKeysetPagination() }},
+		{"locales", false, func() *Collection { return c.Locales.List(spaceID) }},
+		{"webhooks", options.SkipWebhooks, func() *Collection { return c.Webhooks.List(spaceID) }},
+		{"roles", options.SkipRoles, func() *Collection { return c.Roles.List(spaceID) }},
+	}
+
+	out := &exportWriter{w: w}
+	out.write("{")
+
+	first := true
+	for _, section := range sections {
+		if section.skip {
+			continue
+		}
+
+		if !first {
+			out.write(",")
+		}
+		first = false
+
+		out.write(fmt.Sprintf("\n  %q: [", section.key))
+
+		count := 0
+		it := Iterate[json.RawMessage](ctx, section.collection())
+		for it.Next() && out.err == nil {
+			if count > 0 {
+				out.write(",")
+			}
+			out.item(it.Value())
+			count++
+
+			if section.key == "assets" && options.AssetsDir != "" {
+				if err := exporter.downloadAsset(ctx, it.Value()); err != nil {
+					return err
+				}
+			}
+		}
+
+		if err := it.Err(); err != nil {
+			return fmt.Errorf("contentful: exporting %s: %w", section.key, err)
+		}
+
+		if count > 0 {
+			out.write("\n  ")
+		}
+		out.write("]")
+	}
+
+	out.write("\n}\n")
+
+	return out.err
+}
+
+// downloadAsset downloads the files of every locale of an asset
+func (exporter *Exporter) downloadAsset(ctx context.Context, raw json.RawMessage) error {
+	var asset Asset
+	if err := json.Unmarshal(raw, &asset); err != nil {
+		return err
+	}
+
+	if asset.Fields == nil {
+		return nil
+	}
+
+	for _, file := range asset.Fields.File {
+		if file == nil || file.URL == "" {
+			continue
+		}
+
+		if err := exporter.download(ctx, file.URL); err != nil {
+			return fmt.Errorf("contentful: downloading asset %s: %w", asset.Sys.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func (exporter *Exporter) download(ctx context.Context, fileURL string) error {
+	// asset urls are protocol relative
+	if strings.HasPrefix(fileURL, "//") {
+		fileURL = "https:" + fileURL
+	}
+
+	u, err := url.Parse(fileURL)
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Clean(exporter.options.AssetsDir)
+	path := filepath.Join(dir, u.Host, filepath.FromSlash(u.Path))
+	if !strings.HasPrefix(path, dir+string(filepath.Separator)) {
+		return fmt.Errorf("contentful: invalid file url %s", fileURL)
+	}
+
+	// the files are public, the management token is not sent
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	res, err := exporter.client.settings().client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("contentful: unexpected status %s for %s", res.Status, fileURL)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(f, res.Body); err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+// exportWriter writes to w until the first error
+type exportWriter struct {
+	w   io.Writer
+	buf bytes.Buffer
+	err error
+}
+
+func (out *exportWriter) write(s string) {
+	if out.err != nil {
+		return
+	}
+
+	_, out.err = io.WriteString(out.w, s)
+}
+
+// item writes an item of an array, indented like contentful-export
+func (out *exportWriter) item(raw json.RawMessage) {
+	if out.err != nil {
+		return
+	}
+
+	out.buf.Reset()
+	if out.err = json.Indent(&out.buf, raw, "    ", "  "); out.err != nil {
+		return
+	}
+
+	out.write("\n    ")
+	if out.err == nil {
+		_, out.err = out.buf.WriteTo(out.w)
+	}
+}
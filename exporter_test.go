@@ -0,0 +1,127 @@
+package contentful
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newExportServer serves the collections of testdata/export.json and the asset files
+func newExportServer(t *testing.T) *httptest.Server {
+	var export map[string][]interface{}
+	err := json.Unmarshal([]byte(readTestData("export.json")), &export)
+	assert.Nil(t, err)
+
+	keys := map[string]string{
+		"content_types":       "contentTypes",
+		"editor_interfaces":   "editorInterfaces",
+		"entries":             "entries",
+		"assets":              "assets",
+		"locales":             "locales",
+		"webhook_definitions": "webhooks",
+		"roles":               "roles",
+	}
+
+	var server *httptest.Server
+	server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/space/") {
+			assert.Empty(t, r.Header.Get("Authorization"))
+			_, _ = fmt.Fprint(w, "png")
+			return
+		}
+
+		checkHeaders(r, assert.New(t))
+
+		items := export[keys[r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]]]
+		if r.URL.Query().Get("skip") != "" && r.URL.Query().Get("skip") != "0" {
+			items = nil
+		}
+
+		body, _ := json.Marshal(map[string]interface{}{
+			"sys":   map[string]interface{}{"type": "Array"},
+			"total": len(items),
+			"skip":  0,
+			"limit": 100,
+			"items": items,
+		})
+
+		// the asset files are served by the test server
+		_, _ = w.Write(bytes.ReplaceAll(body, []byte("//assets.test"), []byte("//"+r.Host)))
+	}))
+
+	return server
+}
+
+func TestExporter_Export(t *testing.T) {
+	assertions := assert.New(t)
+
+	server := newExportServer(t)
+	defer server.Close()
+
+	cma = NewCMA(CMAToken, WithBaseURL(server.URL), WithHTTPClient(server.Client()))
+
+	dir := t.TempDir()
+	out := &bytes.Buffer{}
+	err := NewExporter(cma, spaceID, &ExportOptions{AssetsDir: dir}).Export(context.Background(), out)
+	assertions.Nil(err)
+
+	host := strings.TrimPrefix(server.URL, "https://")
+	expected := strings.ReplaceAll(readTestData("export.json"), "//assets.test", "//"+host)
+	assertions.JSONEq(expected, out.String())
+	assertions.True(strings.HasPrefix(out.String(), "{\n  \"contentTypes\": [\n    {\n      \""))
+
+	content, err := os.ReadFile(filepath.Join(dir, host, "space", "cover-1", "token", "cover.png"))
+	assertions.Nil(err)
+	assertions.Equal("png", string(content))
+}
+
+func TestExporter_Skip(t *testing.T) {
+	assertions := assert.New(t)
+
+	server := newExportServer(t)
+	defer server.Close()
+
+	cma = NewCMA(CMAToken, WithBaseURL(server.URL), WithHTTPClient(server.Client()))
+
+	out := &bytes.Buffer{}
+	err := cma.Space(spaceID).Environment("master").Exporter(&ExportOptions{
+		SkipContent:          true,
+		SkipEditorInterfaces: true,
+		SkipRoles:            true,
+		SkipWebhooks:         true,
+	}).Export(context.Background(), out)
+	assertions.Nil(err)
+
+	var export map[string][]interface{}
+	assertions.Nil(json.Unmarshal(out.Bytes(), &export))
+	assertions.Len(export, 2)
+	assertions.Len(export["contentTypes"], 1)
+	assertions.Len(export["locales"], 1)
+}
+
+func TestExporter_Error(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(404)
+		_, _ = fmt.Fprintln(w, readTestData("error_notfound.json"))
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	cma = NewCMA(CMAToken, WithBaseURL(server.URL))
+
+	err := NewExporter(cma, spaceID, nil).Export(context.Background(), &bytes.Buffer{})
+	assertions.ErrorAs(err, &NotFoundError{})
+	assertions.Contains(err.Error(), "exporting contentTypes")
+}
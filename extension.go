@@ -2,6 +2,7 @@ package contentful
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -42,41 +43,32 @@ func (extension *Extension) GetVersion() int {
 
 // List returns an extensions collection
 func (service *ExtensionsService) List(spaceID string) *Collection {
-	path := fmt.Sprintf("/spaces/%s/environments/%s/extensions", spaceID, service.c.Environment)
-
-	req, err := service.c.newRequest("GET", path, nil, nil)
-	if err != nil {
-		return &Collection{}
-	}
-
-	col := NewCollection(&CollectionOptions{})
-	col.c = service.c
-	col.req = req
-
-	return col
+	return service.c.environmentCollection(func(environment string) string {
+		return fmt.Sprintf("/spaces/%s/environments/%s/extensions", spaceID, environment)
+	})
 }
 
 // Get returns a single extension
-func (service *ExtensionsService) Get(spaceID, extensionID string) (*Extension, error) {
-	path := fmt.Sprintf("/spaces/%s/environments/%s/extensions/%s", spaceID, service.c.Environment, extensionID)
+func (service *ExtensionsService) Get(ctx context.Context, spaceID, extensionID string) (*Extension, error) {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/extensions/%s", spaceID, service.c.environment(ctx), extensionID)
 	query := url.Values{}
 	method := "GET"
 
-	req, err := service.c.newRequest(method, path, query, nil)
+	req, err := service.c.newRequest(ctx, method, path, query, nil)
 	if err != nil {
 		return &Extension{}, err
 	}
 
 	var extension Extension
-	if ok := service.c.do(req, &extension); ok != nil {
+	if err := service.c.do(req, &extension); err != nil {
 		return nil, err
 	}
 
-	return &extension, err
+	return &extension, nil
 }
 
 // Upsert updates or creates a new extension
-func (service *ExtensionsService) Upsert(spaceID string, e *Extension) error {
+func (service *ExtensionsService) Upsert(ctx context.Context, spaceID string, e *Extension) error {
 	bytesArray, err := json.Marshal(e)
 	if err != nil {
 		return err
@@ -86,14 +78,14 @@ func (service *ExtensionsService) Upsert(spaceID string, e *Extension) error {
 	var method string
 
 	if e.Sys != nil && e.Sys.ID != "" {
-		path = fmt.Sprintf("/spaces/%s/environments/%s/extensions/%s", spaceID, service.c.Environment, e.Sys.ID)
+		path = fmt.Sprintf("/spaces/%s/environments/%s/extensions/%s", spaceID, service.c.environment(ctx), e.Sys.ID)
 		method = "PUT"
 	} else {
-		path = fmt.Sprintf("/spaces/%s/environments/%s/extensions", spaceID, service.c.Environment)
+		path = fmt.Sprintf("/spaces/%s/environments/%s/extensions", spaceID, service.c.environment(ctx))
 		method = "POST"
 	}
 
-	req, err := service.c.newRequest(method, path, nil, bytes.NewReader(bytesArray))
+	req, err := service.c.newRequest(ctx, method, path, nil, bytes.NewReader(bytesArray))
 	if err != nil {
 		return err
 	}
@@ -104,11 +96,11 @@ func (service *ExtensionsService) Upsert(spaceID string, e *Extension) error {
 }
 
 // Delete the extension
-func (service *ExtensionsService) Delete(spaceID string, extensionID string) error {
-	path := fmt.Sprintf("/spaces/%s/environments/%s/extensions/%s", spaceID, service.c.Environment, extensionID)
+func (service *ExtensionsService) Delete(ctx context.Context, spaceID string, extensionID string) error {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/extensions/%s", spaceID, service.c.environment(ctx), extensionID)
 	method := "DELETE"
 
-	req, err := service.c.newRequest(method, path, nil, nil)
+	req, err := service.c.newRequest(ctx, method, path, nil, nil)
 	if err != nil {
 		return err
 	}
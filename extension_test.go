@@ -1,6 +1,7 @@
 package contentful
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -30,9 +31,9 @@ func TestExtensionsService_List(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	collection, err := cma.Extensions.List(spaceID).Next()
+	collection, err := cma.Extensions.List(spaceID).Next(context.Background())
 	assertions.Nil(err)
 
 	extensions := collection.ToExtension()
@@ -61,9 +62,9 @@ func TestExtensionsService_Get(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	extension, err := cma.Extensions.Get(spaceID, "0xvkPW9FdQ1kkWlWZ8ga4x")
+	extension, err := cma.Extensions.Get(context.Background(), spaceID, "0xvkPW9FdQ1kkWlWZ8ga4x")
 	assertions.Nil(err)
 	assertions.Equal("0xvkPW9FdQ1kkWlWZ8ga4x", extension.Sys.ID)
 }
@@ -88,10 +89,11 @@ func TestExtensionsService_Get_2(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	_, err = cma.Extensions.Get(spaceID, "0xvkPW9FdQ1kkWlWZ8ga4x")
-	assertions.Nil(err)
+	extension, err := cma.Extensions.Get(context.Background(), spaceID, "0xvkPW9FdQ1kkWlWZ8ga4x")
+	assertions.NotNil(err)
+	assertions.Nil(extension)
 }
 
 func TestExtensionsService_Upsert_Create(t *testing.T) {
@@ -119,7 +121,7 @@ func TestExtensionsService_Upsert_Create(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	extension := &Extension{
 		Extension: ExtensionDetails{
@@ -137,7 +139,7 @@ func TestExtensionsService_Upsert_Create(t *testing.T) {
 		},
 	}
 
-	err := cma.Extensions.Upsert(spaceID, extension)
+	err := cma.Extensions.Upsert(context.Background(), spaceID, extension)
 	assertions.Nil(err)
 	assertions.Equal("https://example.com/my", extension.Extension.SRC)
 	assertions.Equal("My awesome extension", extension.Extension.Name)
@@ -169,14 +171,14 @@ func TestExtensionsService_Upsert_Update(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	extension, err := extensionFromTestFile("extension_1.json")
 	assertions.Nil(err)
 
 	extension.Extension.Name = "The updated extension"
 
-	err = cma.Extensions.Upsert(spaceID, extension)
+	err = cma.Extensions.Upsert(context.Background(), spaceID, extension)
 	assertions.Nil(err)
 	assertions.Equal("The updated extension", extension.Extension.Name)
 }
@@ -199,11 +201,11 @@ func TestExtensionsService_Delete(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	extension, err := extensionFromTestFile("extension_1.json")
 	assertions.Nil(err)
 
-	err = cma.Extensions.Delete(spaceID, extension.Sys.ID)
+	err = cma.Extensions.Delete(context.Background(), spaceID, extension.Sys.ID)
 	assertions.Nil(err)
 }
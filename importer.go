@@ -0,0 +1,656 @@
+package contentful
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"time"
+)
+
+// noinspection GoUnusedConst
+const (
+	// ImportStatusCreated the entity did not exist in the space
+	ImportStatusCreated = "created"
+
+	// ImportStatusUpdated the entity existed in the space and was updated
+	ImportStatusUpdated = "updated"
+
+	// ImportStatusFailed the entity could not be imported
+	ImportStatusFailed = "failed"
+)
+
+// DefaultImportConcurrency is the number of workers of an importer
+const DefaultImportConcurrency = 4
+
+// DefaultImportVersionRetries is the number of times an upsert is retried
+// after a version mismatch
+const DefaultImportVersionRetries = 3
+
+// DefaultAssetProcessingInterval is the interval between two polls of a processed asset
+const DefaultAssetProcessingInterval = time.Second
+
+// DefaultAssetProcessingTimeout is how long an importer waits for the files of an asset to be processed
+const DefaultAssetProcessingTimeout = 2 * time.Minute
+
+// ImportItem is an entry or an asset to import, only one of them is set.
+// Entries and assets are updated in place with the response of the api.
+type ImportItem struct {
+	Entry *Entry `json:"entry,omitempty"`
+	Asset *Asset `json:"asset,omitempty"`
+
+	// ContentTypeID of the entry, read from the sys of the entry when empty
+	ContentTypeID string `json:"contentTypeId,omitempty"`
+
+	// Publish publishes the entity even when ImportOptions.Publish is not set
+	Publish bool `json:"publish,omitempty"`
+}
+
+// ImportOptions configure an Importer
+type ImportOptions struct {
+	// Concurrency is the number of items imported at the same time,
+	// DefaultImportConcurrency when zero. The workers share the client and
+	// therefore its rate limiter.
+	Concurrency int
+
+	// Publish publishes every entity after upserting it
+	Publish bool
+
+	// VersionRetries is the number of times an upsert is retried with the
+	// current version of the entity after a VersionMismatchError,
+	// DefaultImportVersionRetries when zero
+	VersionRetries int
+
+	// ProcessingInterval is the interval between two polls of an asset whose
+	// files are processed, DefaultAssetProcessingInterval when zero
+	ProcessingInterval time.Duration
+
+	// ProcessingTimeout is how long the files of an asset may take to be
+	// processed, DefaultAssetProcessingTimeout when zero
+	ProcessingTimeout time.Duration
+}
+
+// ImportResult is the outcome of the import of an item
+type ImportResult struct {
+	Item      *ImportItem
+	LinkType  string
+	ID        string
+	Status    string
+	Published bool
+	Attempts  int
+	Err       error
+}
+
+// ImportReport holds the results of an import, in the order of the items
+type ImportReport struct {
+	Results []*ImportResult
+}
+
+// Importer upserts, and optionally publishes, entries and assets with a pool
+// of workers. Entries are imported after the entries and assets they link to
+// when those are part of the same import.
+type Importer struct {
+	client  *Client
+	spaceID string
+	options ImportOptions
+}
+
+// importNode is an item waiting for, or going through, a worker
+type importNode struct {
+	item       *ImportItem
+	result     *ImportResult
+	key        string
+	waiting    int
+	dependents []*importNode
+	queued     bool
+	done       bool
+}
+
+// NewImporter returns an importer into the space and the environment of the client
+func NewImporter(c *Client, spaceID string, options *ImportOptions) *Importer {
+	importer := &Importer{client: c, spaceID: spaceID}
+	if options != nil {
+		importer.options = *options
+	}
+
+	if importer.options.Concurrency <= 0 {
+		importer.options.Concurrency = DefaultImportConcurrency
+	}
+
+	if importer.options.VersionRetries <= 0 {
+		importer.options.VersionRetries = DefaultImportVersionRetries
+	}
+
+	if importer.options.ProcessingInterval <= 0 {
+		importer.options.ProcessingInterval = DefaultAssetProcessingInterval
+	}
+
+	if importer.options.ProcessingTimeout <= 0 {
+		importer.options.ProcessingTimeout = DefaultAssetProcessingTimeout
+	}
+
+	return importer
+}
+
+// Created returns the number of created entities
+func (report *ImportReport) Created() int {
+	return report.count(ImportStatusCreated)
+}
+
+// Updated returns the number of updated entities
+func (report *ImportReport) Updated() int {
+	return report.count(ImportStatusUpdated)
+}
+
+// Failed returns the number of entities which could not be imported
+func (report *ImportReport) Failed() int {
+	return report.count(ImportStatusFailed)
+}
+
+// Err joins the errors of the failed items, it is nil when every item was imported
+func (report *ImportReport) Err() error {
+	var errs []error
+	for _, result := range report.Results {
+		if result.Err != nil {
+			errs = append(errs, fmt.Errorf("%s %s: %w", result.LinkType, result.ID, result.Err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (report *ImportReport) count(status string) int {
+	count := 0
+	for _, result := range report.Results {
+		if result.Status == status {
+			count++
+		}
+	}
+
+	return count
+}
+
+// ImportAll imports the given items
+func (importer *Importer) ImportAll(ctx context.Context, items []*ImportItem) (*ImportReport, error) {
+	stream := make(chan *ImportItem, len(items))
+	for _, item := range items {
+		stream <- item
+	}
+	close(stream)
+
+	return importer.Import(ctx, stream)
+}
+
+// Import imports the items received until the channel is closed. An entry
+// linking to an entity which was not received yet waits for it until the
+// channel is closed, after which links are considered to point to entities
+// already in the space. Entries linking to each other are imported in any
+// order. The error is the error of the context, the errors of the items are
+// part of the report.
+func (importer *Importer) Import(ctx context.Context, items <-chan *ImportItem) (*ImportReport, error) {
+	report := &ImportReport{}
+
+	jobs := make(chan *importNode)
+	done := make(chan *importNode)
+
+	var wg sync.WaitGroup
+	for i := 0; i < importer.options.Concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for node := range jobs {
+				importer.importItem(ctx, node.item, node.result)
+				done <- node
+			}
+		}()
+	}
+
+	nodes := map[string]*importNode{}
+	missing := map[string][]*importNode{}
+	var pending []*importNode
+	var ready []*importNode
+	running := 0
+
+	var err error
+	for items != nil || len(ready) > 0 || running > 0 || len(pending) > 0 {
+		if items == nil && len(ready) == 0 && running == 0 {
+			// the remaining entries link to each other
+			for _, node := range pending {
+				node.queued = true
+				ready = append(ready, node)
+			}
+			pending = nil
+		}
+
+		var send chan *importNode
+		var next *importNode
+		if len(ready) > 0 {
+			send = jobs
+			next = ready[0]
+		}
+
+		select {
+		case item, ok := <-items:
+			if !ok {
+				items = nil
+
+				// links to entities which are not part of the import
+				for _, waiters := range missing {
+					for _, node := range waiters {
+						node.waiting--
+						if node.waiting == 0 {
+							node.queued = true
+							ready = append(ready, node)
+						}
+					}
+				}
+				missing = nil
+				pending = unqueued(pending)
+
+				continue
+			}
+
+			node := newImportNode(item)
+			report.Results = append(report.Results, node.result)
+
+			for _, key := range importLinks(item) {
+				if key == node.key {
+					continue
+				}
+
+				dependency, ok := nodes[key]
+				switch {
+				case ok && dependency.done:
+					// imported already
+				case ok:
+					node.waiting++
+					dependency.dependents = append(dependency.dependents, node)
+				default:
+					node.waiting++
+					missing[key] = append(missing[key], node)
+				}
+			}
+
+			// entries waiting for this one
+			for _, waiter := range missing[node.key] {
+				node.dependents = append(node.dependents, waiter)
+			}
+			delete(missing, node.key)
+			nodes[node.key] = node
+
+			if node.waiting == 0 {
+				node.queued = true
+				ready = append(ready, node)
+			} else {
+				pending = append(pending, node)
+			}
+		case send <- next:
+			ready = ready[1:]
+			running++
+		case node := <-done:
+			running--
+			node.done = true
+			for _, dependent := range node.dependents {
+				dependent.waiting--
+				if dependent.waiting == 0 && !dependent.queued {
+					dependent.queued = true
+					ready = append(ready, dependent)
+				}
+			}
+			pending = unqueued(pending)
+		case <-ctx.Done():
+			err = ctx.Err()
+			items = nil
+			ready = nil
+			pending = nil
+
+			// wait for the running imports, which fail with the context
+			for ; running > 0; running-- {
+				<-done
+			}
+		}
+	}
+
+	close(jobs)
+	wg.Wait()
+
+	for _, result := range report.Results {
+		if result.Attempts == 0 {
+			result.Status = ImportStatusFailed
+			result.Err = err
+		}
+	}
+
+	return report, err
+}
+
+// importItem upserts, processes the files of assets and publishes the entity of an item
+func (importer *Importer) importItem(ctx context.Context, item *ImportItem, result *ImportResult) {
+	for {
+		result.Attempts++
+
+		err := importer.upsert(ctx, item)
+		var mismatch VersionMismatchError
+		if errors.As(err, &mismatch) && result.Attempts <= importer.options.VersionRetries {
+			if err = importer.refreshVersion(ctx, item); err == nil {
+				continue
+			}
+		}
+
+		if err != nil {
+			result.Status = ImportStatusFailed
+			result.Err = err
+
+			return
+		}
+
+		break
+	}
+
+	sys := itemSys(item)
+	result.ID = sys.ID
+	result.Status = ImportStatusUpdated
+	if sys.Version <= 1 {
+		result.Status = ImportStatusCreated
+	}
+
+	if item.Asset != nil {
+		if err := importer.processAsset(ctx, item); err != nil {
+			result.Status = ImportStatusFailed
+			result.Err = err
+
+			return
+		}
+	}
+
+	if !importer.options.Publish && !item.Publish {
+		return
+	}
+
+	var err error
+	if item.Entry != nil {
+		err = importer.client.Entries.Publish(ctx, importer.spaceID, item.Entry)
+	} else {
+		err = importer.client.Assets.Publish(ctx, importer.spaceID, item.Asset)
+	}
+
+	if err != nil {
+		result.Status = ImportStatusFailed
+		result.Err = err
+
+		return
+	}
+
+	result.Published = true
+}
+
+func (importer *Importer) upsert(ctx context.Context, item *ImportItem) error {
+	if item.Asset != nil {
+		return importer.client.Assets.Upsert(ctx, importer.spaceID, item.Asset)
+	}
+
+	if item.Entry == nil {
+		return errors.New("contentful: import item has neither an entry nor an asset")
+	}
+
+	contentTypeID := item.ContentTypeID
+	if contentTypeID == "" && item.Entry.Sys != nil && item.Entry.Sys.ContentType != nil {
+		contentTypeID = item.Entry.Sys.ContentType.Sys.ID
+	}
+
+	return importer.client.Entries.Upsert(ctx, importer.spaceID, contentTypeID, item.Entry)
+}
+
+// processAsset processes the files which were uploaded but not processed
+// yet, then waits for all of them to be processed
+func (importer *Importer) processAsset(ctx context.Context, item *ImportItem) error {
+	asset := item.Asset
+	if asset.Fields == nil {
+		return nil
+	}
+
+	var locales []string
+	for locale, file := range asset.Fields.File {
+		if file != nil && file.URL == "" && (file.UploadURL != "" || file.UploadFrom != nil) {
+			locales = append(locales, locale)
+		}
+	}
+
+	if len(locales) == 0 {
+		return nil
+	}
+
+	sort.Strings(locales)
+	for _, locale := range locales {
+		asset.Locale = locale
+		if err := importer.client.Assets.Process(ctx, importer.spaceID, asset); err != nil {
+			return err
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, importer.options.ProcessingTimeout)
+	defer cancel()
+
+	for {
+		if err := sleep(ctx, importer.options.ProcessingInterval); err != nil {
+			if errors.Is(err, context.DeadlineExceeded) {
+				return fmt.Errorf("contentful: asset %s was not processed after %s", asset.Sys.ID, importer.options.ProcessingTimeout)
+			}
+
+			return err
+		}
+
+		current, err := importer.client.Assets.Get(ctx, importer.spaceID, asset.Sys.ID)
+		if err != nil {
+			return err
+		}
+
+		if processed(current, locales) {
+			item.Asset = current
+
+			return nil
+		}
+	}
+}
+
+// processed reports whether the files of the given locales have an url
+func processed(asset *Asset, locales []string) bool {
+	if asset.Fields == nil {
+		return false
+	}
+
+	for _, locale := range locales {
+		if file := asset.Fields.File[locale]; file == nil || file.URL == "" {
+			return false
+		}
+	}
+
+	return true
+}
+
+// refreshVersion sets the version of the entity to the one of the space, or
+// to zero when it does not exist in the space
+func (importer *Importer) refreshVersion(ctx context.Context, item *ImportItem) error {
+	sys := itemSys(item)
+
+	var current *Sys
+	var err error
+	if item.Entry != nil {
+		var entry *Entry
+		if entry, err = importer.client.Entries.Get(ctx, importer.spaceID, sys.ID); err == nil {
+			current = entry.Sys
+		}
+	} else {
+		var asset *Asset
+		if asset, err = importer.client.Assets.Get(ctx, importer.spaceID, sys.ID); err == nil {
+			current = asset.Sys
+		}
+	}
+
+	var notFound NotFoundError
+	switch {
+	case errors.As(err, &notFound):
+		sys.Version = 0
+	case err != nil:
+		return err
+	default:
+		sys.Version = current.Version
+	}
+
+	return nil
+}
+
+// ReadExportItems sends the entries and the assets of a contentful-export
+// JSON document to the channel while decoding it, then closes the channel.
+// The other resources of the export are skipped.
+func ReadExportItems(ctx context.Context, r io.Reader, items chan<- *ImportItem) error {
+	defer close(items)
+
+	decoder := json.NewDecoder(r)
+	if err := expectDelim(decoder, '{'); err != nil {
+		return err
+	}
+
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+
+		key, _ := token.(string)
+		if key != "entries" && key != "assets" {
+			var skipped json.RawMessage
+			if err := decoder.Decode(&skipped); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if err := expectDelim(decoder, '['); err != nil {
+			return err
+		}
+
+		for decoder.More() {
+			item := &ImportItem{}
+			if key == "entries" {
+				err = decoder.Decode(&item.Entry)
+			} else {
+				err = decoder.Decode(&item.Asset)
+			}
+
+			if err != nil {
+				return err
+			}
+
+			select {
+			case items <- item:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+
+		if err := expectDelim(decoder, ']'); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func newImportNode(item *ImportItem) *importNode {
+	result := &ImportResult{Item: item, LinkType: "Entry"}
+	if item.Asset != nil {
+		result.LinkType = "Asset"
+	}
+
+	if sys := itemSys(item); sys != nil {
+		result.ID = sys.ID
+	}
+
+	key := ""
+	if result.ID != "" {
+		key = result.LinkType + ":" + result.ID
+	}
+
+	return &importNode{item: item, result: result, key: key}
+}
+
+// itemSys returns the sys of the entity of an item, nil until it is created
+func itemSys(item *ImportItem) *Sys {
+	switch {
+	case item.Entry != nil:
+		return item.Entry.Sys
+	case item.Asset != nil:
+		return item.Asset.Sys
+	default:
+		return nil
+	}
+}
+
+// importLinks returns the keys of the entries and assets an entry links to
+func importLinks(item *ImportItem) []string {
+	if item.Entry == nil {
+		return nil
+	}
+
+	seen := map[string]bool{}
+	var keys []string
+
+	var walk func(value interface{})
+	walk = func(value interface{}) {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			if linkType, id, ok := parseLink(v); ok {
+				if key := linkType + ":" + id; !seen[key] && (linkType == "Entry" || linkType == "Asset") {
+					seen[key] = true
+					keys = append(keys, key)
+				}
+
+				return
+			}
+
+			for _, item := range v {
+				walk(item)
+			}
+		case []interface{}:
+			for _, item := range v {
+				walk(item)
+			}
+		}
+	}
+
+	for _, value := range item.Entry.Fields {
+		walk(value)
+	}
+
+	return keys
+}
+
+// unqueued removes the queued nodes
+func unqueued(nodes []*importNode) []*importNode {
+	kept := nodes[:0]
+	for _, node := range nodes {
+		if !node.queued {
+			kept = append(kept, node)
+		}
+	}
+
+	return kept
+}
+
+func expectDelim(decoder *json.Decoder, delim json.Delim) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+
+	if token != delim {
+		return fmt.Errorf("contentful: expected %q in export, got %v", delim, token)
+	}
+
+	return nil
+}
@@ -0,0 +1,252 @@
+package contentful
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeSpace serves entries and assets from memory, like the management api
+type fakeSpace struct {
+	mu        sync.Mutex
+	versions  map[string]int
+	upserts   []string
+	published []string
+	created   int
+}
+
+func newFakeSpace(versions map[string]int) (*fakeSpace, *httptest.Server) {
+	space := &fakeSpace{versions: versions}
+	if space.versions == nil {
+		space.versions = map[string]int{}
+	}
+
+	return space, httptest.NewServer(space)
+}
+
+func (s *fakeSpace) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// /spaces/{space}/environments/{environment}/{entries|assets}[/{id}[/published]]
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/"), "/")[4:]
+	resource := parts[0]
+
+	if len(parts) == 1 {
+		s.created++
+		parts = append(parts, fmt.Sprintf("new-%d", s.created))
+	}
+
+	key := resource + "/" + parts[1]
+	version, exists := s.versions[key]
+
+	switch {
+	case r.Method == http.MethodGet && !exists, len(parts) == 3 && !exists:
+		w.WriteHeader(404)
+		_, _ = fmt.Fprintln(w, readTestData("error_notfound.json"))
+		return
+	case r.Method == http.MethodGet:
+	case exists && r.Header.Get("X-Contentful-Version") != strconv.Itoa(version):
+		w.WriteHeader(409)
+		_, _ = fmt.Fprintln(w, readTestData("error_version_mismatch.json"))
+		return
+	case len(parts) == 3:
+		version++
+		s.published = append(s.published, key)
+	default:
+		version++
+		s.upserts = append(s.upserts, key)
+	}
+
+	s.versions[key] = version
+	w.WriteHeader(200)
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"sys":    map[string]interface{}{"id": parts[1], "version": version},
+		"fields": map[string]interface{}{},
+	})
+}
+
+func exportItems(t *testing.T) []*ImportItem {
+	f, err := os.Open("testdata/export.json")
+	assert.Nil(t, err)
+	defer f.Close()
+
+	stream := make(chan *ImportItem)
+	errs := make(chan error, 1)
+	go func() {
+		errs <- ReadExportItems(context.Background(), f, stream)
+	}()
+
+	var items []*ImportItem
+	for item := range stream {
+		items = append(items, item)
+	}
+	assert.Nil(t, <-errs)
+
+	return items
+}
+
+func TestReadExportItems(t *testing.T) {
+	assertions := assert.New(t)
+
+	items := exportItems(t)
+	assertions.Len(items, 3)
+	assertions.Equal("post-1", items[0].Entry.Sys.ID)
+	assertions.Equal("post", items[0].Entry.Sys.ContentType.Sys.ID)
+	assertions.Equal("author-1", items[1].Entry.Sys.ID)
+	assertions.Equal("cover-1", items[2].Asset.Sys.ID)
+	assertions.ElementsMatch([]string{"Entry:author-1", "Asset:cover-1"}, importLinks(items[0]))
+}
+
+func TestImporter_ImportAll(t *testing.T) {
+	assertions := assert.New(t)
+
+	space, server := newFakeSpace(map[string]int{"entries/post-1": 7})
+	defer server.Close()
+
+	cma = NewCMA(CMAToken)
+	cma.baseURL = server.URL
+
+	importer := NewImporter(cma, spaceID, &ImportOptions{Concurrency: 3, Publish: true})
+	report, err := importer.ImportAll(context.Background(), exportItems(t))
+	assertions.Nil(err)
+	assertions.Nil(report.Err())
+
+	// the post links to the author and the cover, which are imported first
+	assertions.Len(space.upserts, 3)
+	assertions.Equal("entries/post-1", space.upserts[2])
+	assertions.ElementsMatch([]string{"entries/post-1", "entries/author-1", "assets/cover-1"}, space.published)
+
+	assertions.Equal(2, report.Created())
+	assertions.Equal(1, report.Updated())
+	assertions.Equal(0, report.Failed())
+
+	// the version of the export did not match the one of the space
+	post := report.Results[0]
+	assertions.Equal("post-1", post.ID)
+	assertions.Equal(ImportStatusUpdated, post.Status)
+	assertions.Equal(2, post.Attempts)
+	assertions.True(post.Published)
+	assertions.Equal(8, post.Item.Entry.Sys.Version)
+}
+
+func TestImporter_Stream(t *testing.T) {
+	assertions := assert.New(t)
+
+	space, server := newFakeSpace(nil)
+	defer server.Close()
+
+	cma = NewCMA(CMAToken)
+	cma.baseURL = server.URL
+
+	link := func(id string) map[string]interface{} {
+		return map[string]interface{}{"en-US": map[string]interface{}{
+			"sys": map[string]interface{}{"type": "Link", "linkType": "Entry", "id": id},
+		}}
+	}
+
+	items := make(chan *ImportItem)
+	go func() {
+		defer close(items)
+
+		// a and b link to each other, c links to an entry of the space
+		items <- &ImportItem{ContentTypeID: "node", Entry: &Entry{Sys: &Sys{ID: "a"}, Fields: map[string]interface{}{"next": link("b")}}}
+		items <- &ImportItem{ContentTypeID: "node", Entry: &Entry{Sys: &Sys{ID: "c"}, Fields: map[string]interface{}{"next": link("existing")}}}
+		items <- &ImportItem{ContentTypeID: "node", Entry: &Entry{Sys: &Sys{ID: "b"}, Fields: map[string]interface{}{"next": link("a")}}}
+		items <- &ImportItem{ContentTypeID: "node", Entry: &Entry{Fields: map[string]interface{}{}}}
+	}()
+
+	report, err := NewImporter(cma, spaceID, nil).Import(context.Background(), items)
+	assertions.Nil(err)
+	assertions.Nil(report.Err())
+	assertions.Len(report.Results, 4)
+	assertions.Equal(4, report.Created())
+	assertions.Equal("new-1", report.Results[3].ID)
+	assertions.ElementsMatch([]string{"entries/a", "entries/b", "entries/c", "entries/new-1"}, space.upserts)
+	assertions.Empty(space.published)
+}
+
+func TestImporter_DeletedDuringImport(t *testing.T) {
+	assertions := assert.New(t)
+
+	var mu sync.Mutex
+	var requests []string
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		requests = append(requests, r.Method+" "+r.Header.Get("X-Contentful-Version"))
+
+		switch {
+		// the entry was deleted from the space after the export
+		case r.Method == http.MethodPut && r.Header.Get("X-Contentful-Version") != "0":
+			w.WriteHeader(409)
+			_, _ = fmt.Fprintln(w, readTestData("error_version_mismatch.json"))
+		case r.Method == http.MethodGet:
+			w.WriteHeader(404)
+			_, _ = fmt.Fprintln(w, readTestData("error_notfound.json"))
+		default:
+			w.WriteHeader(201)
+			_, _ = fmt.Fprintln(w, `{"sys": {"id": "post-1", "version": 1}}`)
+		}
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	cma = NewCMA(CMAToken, WithBaseURL(server.URL))
+
+	report, err := NewImporter(cma, spaceID, nil).ImportAll(context.Background(), []*ImportItem{
+		{ContentTypeID: "post", Entry: &Entry{Sys: &Sys{ID: "post-1", Version: 3}}},
+	})
+	assertions.Nil(err)
+	assertions.Nil(report.Err())
+	assertions.Equal(1, report.Created())
+	assertions.Equal([]string{"PUT 3", "GET ", "PUT 0"}, requests)
+}
+
+func TestImporter_Failed(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(400)
+		_, _ = fmt.Fprintln(w, readTestData("error_validation_failed.json"))
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	cma = NewCMA(CMAToken)
+	cma.baseURL = server.URL
+
+	report, err := NewImporter(cma, spaceID, nil).ImportAll(context.Background(), []*ImportItem{
+		{ContentTypeID: "post", Entry: &Entry{Sys: &Sys{ID: "post-1"}}},
+		{},
+	})
+	assertions.Nil(err)
+	assertions.Equal(2, report.Failed())
+	assertions.Contains(report.Results[0].Err.Error(), "Invalid JSON in request body")
+	assertions.Contains(report.Err().Error(), "import item has neither an entry nor an asset")
+}
+
+func TestImporter_Canceled(t *testing.T) {
+	assertions := assert.New(t)
+
+	cma = NewCMA(CMAToken)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	items := make(chan *ImportItem)
+	report, err := NewImporter(cma, spaceID, nil).Import(ctx, items)
+	assertions.ErrorIs(err, context.Canceled)
+	assertions.Empty(report.Results)
+}
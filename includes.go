@@ -1,9 +1,20 @@
 package contentful
 
+import (
+	"errors"
+	"fmt"
+)
+
+// Includes model, holds the linked entries and assets of a collection
 type Includes struct {
-	Asset []IncludesAsset `json:"Asset"`
+	Entry []*Entry `json:"Entry,omitempty"`
+	Asset []*Asset `json:"Asset,omitempty"`
 }
 
+// IncludesAsset model
+//
+// Deprecated: included assets are decoded into Asset, which supports
+// both localized and single locale fields.
 type IncludesAsset struct {
 	Metadata struct {
 		Tags []interface{} `json:"tags"`
@@ -42,6 +53,9 @@ type IncludesAsset struct {
 	} `json:"fields"`
 }
 
+// IncludesAssetDetails model
+//
+// Deprecated: see IncludesAsset.
 type IncludesAssetDetails struct {
 	Size  int `json:"size"`
 	Image struct {
@@ -49,3 +63,175 @@ type IncludesAssetDetails struct {
 		Height int `json:"height"`
 	} `json:"image"`
 }
+
+// UnresolvedLinkError reports a link which target is not part of the includes,
+// e.g. because it is not published
+type UnresolvedLinkError struct {
+	LinkType string
+	ID       string
+}
+
+func (e UnresolvedLinkError) Error() string {
+	return fmt.Sprintf("contentful: unresolved %s link %s", e.LinkType, e.ID)
+}
+
+// ResolveLinks returns the entries of the collection with the links in their
+// fields replaced by the included *Entry and *Asset. Linked entries are
+// resolved up to the include depth of the query, which defaults to 1 like the
+// api. A link back to an entry already on the resolution path is left as is.
+// Links which can not be resolved are left as is and reported as
+// UnresolvedLinkError, joined in the returned error.
+func (col *Collection) ResolveLinks() ([]*Entry, error) {
+	entries, err := DecodeItems[*Entry](col.Items)
+	if err != nil {
+		return nil, err
+	}
+
+	// the items are indexed from a separate copy, as entries are resolved in place
+	items, err := DecodeItems[*Entry](col.Items)
+	if err != nil {
+		return nil, err
+	}
+
+	depth := int(col.Query.include)
+	if depth == 0 {
+		depth = 1
+	}
+
+	r := &linkResolver{
+		entries:    map[string]*Entry{},
+		assets:     map[string]*Asset{},
+		depth:      depth,
+		unresolved: map[string]bool{},
+	}
+
+	for _, entry := range append(items, col.Includes.Entry...) {
+		if entry != nil && entry.Sys != nil {
+			r.entries[entry.Sys.ID] = entry
+		}
+	}
+
+	for _, asset := range col.Includes.Asset {
+		if asset != nil && asset.Sys != nil {
+			r.assets[asset.Sys.ID] = asset
+		}
+	}
+
+	for _, entry := range entries {
+		path := map[string]bool{}
+		if entry.Sys != nil {
+			path[entry.Sys.ID] = true
+		}
+
+		r.resolveEntry(entry, 1, path)
+	}
+
+	return entries, errors.Join(r.errs...)
+}
+
+type linkResolver struct {
+	entries    map[string]*Entry
+	assets     map[string]*Asset
+	depth      int
+	errs       []error
+	unresolved map[string]bool
+}
+
+func (r *linkResolver) resolveEntry(entry *Entry, level int, path map[string]bool) {
+	for key, value := range entry.Fields {
+		entry.Fields[key] = r.resolveValue(value, level, path)
+	}
+}
+
+func (r *linkResolver) resolveValue(value interface{}, level int, path map[string]bool) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if linkType, id, ok := parseLink(v); ok {
+			return r.resolveLink(v, linkType, id, level, path)
+		}
+
+		for key, item := range v {
+			v[key] = r.resolveValue(item, level, path)
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = r.resolveValue(item, level, path)
+		}
+	}
+
+	return value
+}
+
+func (r *linkResolver) resolveLink(link map[string]interface{}, linkType, id string, level int, path map[string]bool) interface{} {
+	switch linkType {
+	case "Asset":
+		if asset, ok := r.assets[id]; ok {
+			return asset
+		}
+	case "Entry":
+		// cycle, the entry is already being resolved
+		if path[id] {
+			return link
+		}
+
+		if entry, ok := r.entries[id]; ok {
+			resolved := &Entry{
+				Locale: entry.Locale,
+				Sys:    entry.Sys,
+				Fields: copyValue(entry.Fields).(map[string]interface{}),
+			}
+
+			if level < r.depth {
+				path[id] = true
+				r.resolveEntry(resolved, level+1, path)
+				delete(path, id)
+			}
+
+			return resolved
+		}
+	default:
+		return link
+	}
+
+	if key := linkType + ":" + id; !r.unresolved[key] {
+		r.unresolved[key] = true
+		r.errs = append(r.errs, UnresolvedLinkError{LinkType: linkType, ID: id})
+	}
+
+	return link
+}
+
+// parseLink returns the link type and id of a link object
+func parseLink(value map[string]interface{}) (linkType, id string, ok bool) {
+	sys, ok := value["sys"].(map[string]interface{})
+	if !ok || sys["type"] != "Link" {
+		return "", "", false
+	}
+
+	linkType, _ = sys["linkType"].(string)
+	id, _ = sys["id"].(string)
+
+	return linkType, id, id != ""
+}
+
+// copyValue deep copies decoded json maps and slices
+func copyValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		c := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			c[key] = copyValue(item)
+		}
+
+		return c
+	case []interface{}:
+		c := make([]interface{}, len(v))
+		for i, item := range v {
+			c[i] = copyValue(item)
+		}
+
+		return c
+	default:
+		return value
+	}
+}
@@ -0,0 +1,94 @@
+package contentful
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func includesCollection(t *testing.T, include uint16) *Collection {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("entries_includes.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	// cda client
+	cda := NewCDA(CDAToken)
+	cda.baseURL = server.URL
+
+	col := cda.Entries.List(spaceID)
+	col.Include(include)
+
+	col, err := col.Next(context.Background())
+	assert.Nil(t, err)
+
+	return col
+}
+
+func TestCollection_ResolveLinks(t *testing.T) {
+	assertions := assert.New(t)
+
+	entries, err := includesCollection(t, 2).ResolveLinks()
+	assertions.Len(entries, 1)
+
+	var unresolved UnresolvedLinkError
+	assertions.True(errors.As(err, &unresolved))
+	assertions.Equal(UnresolvedLinkError{LinkType: "Entry", ID: "garfield"}, unresolved)
+
+	nyanCat := entries[0]
+	image, ok := nyanCat.Fields["image"].(*Asset)
+	assertions.True(ok)
+	assertions.Equal("Nyan Cat", image.Fields.Title["en-US"])
+	assertions.Equal("nyancat.png", image.Fields.File["en-US"].FileName)
+	assertions.Equal(250, image.Fields.File["en-US"].Details.Image.Width)
+
+	happyCat, ok := nyanCat.Fields["bestFriend"].(*Entry)
+	assertions.True(ok)
+	assertions.Equal("Happy Cat", happyCat.Fields["name"])
+
+	// second level is resolved with include=2
+	yarn, ok := happyCat.Fields["toy"].(*Entry)
+	assertions.True(ok)
+	assertions.Equal("Yarn", yarn.Fields["name"])
+
+	// the link back to nyan cat is a cycle and stays a link
+	_, ok = happyCat.Fields["bestFriend"].(map[string]interface{})
+	assertions.True(ok)
+
+	// unresolvable links stay links
+	enemies := nyanCat.Fields["enemies"].([]interface{})
+	_, ok = enemies[0].(map[string]interface{})
+	assertions.True(ok)
+}
+
+func TestCollection_ResolveLinksDepth(t *testing.T) {
+	assertions := assert.New(t)
+
+	entries, _ := includesCollection(t, 0).ResolveLinks()
+
+	happyCat, ok := entries[0].Fields["bestFriend"].(*Entry)
+	assertions.True(ok)
+
+	// the default include depth of 1 leaves the links of happy cat as is
+	_, ok = happyCat.Fields["toy"].(map[string]interface{})
+	assertions.True(ok)
+}
+
+func TestAsset_UnmarshalJSONSingleLocale(t *testing.T) {
+	assertions := assert.New(t)
+
+	col := includesCollection(t, 1)
+	asset := col.Includes.Asset[0]
+	assertions.Equal("en-US", asset.Locale)
+	assertions.Equal("Nyan Cat", asset.Fields.Title["en-US"])
+	assertions.Nil(asset.Fields.Description)
+}
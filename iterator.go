@@ -0,0 +1,142 @@
+package contentful
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+)
+
+// ErrCollectionNotInitialized is returned when iterating a collection which has no request,
+// e.g. because the service failed to build it
+var ErrCollectionNotInitialized = errors.New("contentful: collection is not initialized")
+
+// Iterator walks every page of a collection and decodes the items into T.
+// Pages are fetched lazily, the first one on the first call to Next, using
+// the pagination mode of the collection.
+//
+//	it := contentful.Iterate[*contentful.Entry](ctx, cma.Entries.List(spaceID))
+//	for it.Next() {
+//		entry := it.Value()
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type Iterator[T any] struct {
+	ctx   context.Context
+	col   *Collection
+	items []T
+	index int
+	done  bool
+	err   error
+}
+
+// Iterate returns an iterator over all items of the collection
+func Iterate[T any](ctx context.Context, col *Collection) *Iterator[T] {
+	it := &Iterator[T]{
+		ctx:   ctx,
+		col:   col,
+		index: -1,
+	}
+
+	if col == nil || (col.req == nil && col.path == nil) {
+		it.err = ErrCollectionNotInitialized
+		it.done = true
+	}
+
+	return it
+}
+
+// Next advances the iterator to the next item, fetching the next page when
+// needed. It returns false when all items are consumed or an error occurred.
+func (it *Iterator[T]) Next() bool {
+	if it.err != nil {
+		return false
+	}
+
+	it.index++
+	for it.index >= len(it.items) {
+		if it.done {
+			return false
+		}
+
+		if !it.fetch() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Value returns the current item
+func (it *Iterator[T]) Value() T {
+	if it.index < 0 || it.index >= len(it.items) {
+		var zero T
+		return zero
+	}
+
+	return it.items[it.index]
+}
+
+// Err returns the first error encountered while fetching or decoding a page
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// Collection returns the underlying collection, holding the metadata and
+// includes of the last fetched page
+func (it *Iterator[T]) Collection() *Collection {
+	return it.col
+}
+
+func (it *Iterator[T]) fetch() bool {
+	if _, err := it.col.Next(it.ctx); err != nil {
+		it.err = err
+		return false
+	}
+
+	items, err := DecodeItems[T](it.col.Items)
+	if err != nil {
+		it.err = err
+		return false
+	}
+
+	it.items = items
+	it.index = 0
+
+	if len(items) == 0 || !it.col.HasNext() {
+		it.done = true
+	}
+
+	return len(items) > 0
+}
+
+// All fetches every page of the collection and returns all items
+func All[T any](ctx context.Context, col *Collection) ([]T, error) {
+	var items []T
+
+	it := Iterate[T](ctx, col)
+	for it.Next() {
+		items = append(items, it.Value())
+	}
+
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// DecodeItems converts raw collection items into T, returning any decode error
+func DecodeItems[T any](items []interface{}) ([]T, error) {
+	byteArray, err := json.Marshal(items)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []T
+	if err := json.Unmarshal(byteArray, &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,111 @@
+package contentful
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func pagedEntriesHandler(total int, assertions *assert.Assertions) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "GET")
+
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		skip, _ := strconv.Atoi(r.URL.Query().Get("skip"))
+		if limit == 0 {
+			limit = 2
+		}
+
+		var items []interface{}
+		for i := skip; i < skip+limit && i < total; i++ {
+			items = append(items, map[string]interface{}{
+				"sys": map[string]interface{}{
+					"id":   fmt.Sprintf("entry-%d", i),
+					"type": "Entry",
+				},
+			})
+		}
+
+		w.WriteHeader(200)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"sys":   map[string]interface{}{"type": "Array"},
+			"total": total,
+			"skip":  skip,
+			"limit": limit,
+			"items": items,
+		})
+	}
+}
+
+func TestIterate(t *testing.T) {
+	assertions := assert.New(t)
+
+	// test server
+	server := httptest.NewServer(pagedEntriesHandler(5, assertions))
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.baseURL = server.URL
+
+	var ids []string
+	it := Iterate[*Entry](context.Background(), cma.Entries.List(spaceID))
+	for it.Next() {
+		ids = append(ids, it.Value().Sys.ID)
+	}
+
+	assertions.Nil(it.Err())
+	assertions.Equal([]string{"entry-0", "entry-1", "entry-2", "entry-3", "entry-4"}, ids)
+	assertions.Equal(5, it.Collection().Total)
+}
+
+func TestAll(t *testing.T) {
+	assertions := assert.New(t)
+
+	// test server
+	server := httptest.NewServer(pagedEntriesHandler(3, assertions))
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.baseURL = server.URL
+
+	entries, err := All[*Entry](context.Background(), cma.Entries.List(spaceID))
+	assertions.Nil(err)
+	assertions.Len(entries, 3)
+	assertions.Equal("entry-2", entries[2].Sys.ID)
+}
+
+func TestIterateDecodeError(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, `{"total": 1, "skip": 0, "limit": 100, "items": [{"sys": "not-an-object"}]}`)
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.baseURL = server.URL
+
+	_, err := All[*Entry](context.Background(), cma.Entries.List(spaceID))
+	assertions.NotNil(err)
+}
+
+func TestIterateNotInitialized(t *testing.T) {
+	assertions := assert.New(t)
+
+	it := Iterate[*Entry](context.Background(), &Collection{})
+	assertions.False(it.Next())
+	assertions.ErrorIs(it.Err(), ErrCollectionNotInitialized)
+}
@@ -2,6 +2,7 @@ package contentful
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"strconv"
@@ -48,27 +49,17 @@ func (locale *Locale) GetVersion() int {
 
 // List returns a locales collection
 func (service *LocalesService) List(spaceID string) *Collection {
-	path := fmt.Sprintf("/spaces/%s/locales", spaceID)
-	method := "GET"
-
-	req, err := service.c.newRequest(method, path, nil, nil)
-	if err != nil {
-		return &Collection{}
-	}
-
-	col := NewCollection(&CollectionOptions{})
-	col.c = service.c
-	col.req = req
-
-	return col
+	return service.c.environmentCollection(func(environment string) string {
+		return fmt.Sprintf("/spaces/%s/environments/%s/locales", spaceID, environment)
+	})
 }
 
 // Get returns a single locale entity
-func (service *LocalesService) Get(spaceID, localeID string) (*Locale, error) {
-	path := fmt.Sprintf("/spaces/%s/locales/%s", spaceID, localeID)
+func (service *LocalesService) Get(ctx context.Context, spaceID, localeID string) (*Locale, error) {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/locales/%s", spaceID, service.c.environment(ctx), localeID)
 	method := "GET"
 
-	req, err := service.c.newRequest(method, path, nil, nil)
+	req, err := service.c.newRequest(ctx, method, path, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -82,11 +73,11 @@ func (service *LocalesService) Get(spaceID, localeID string) (*Locale, error) {
 }
 
 // Delete the locale
-func (service *LocalesService) Delete(spaceID string, locale *Locale) error {
-	path := fmt.Sprintf("/spaces/%s/locales/%s", spaceID, locale.Sys.ID)
+func (service *LocalesService) Delete(ctx context.Context, spaceID string, locale *Locale) error {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/locales/%s", spaceID, service.c.environment(ctx), locale.Sys.ID)
 	method := "DELETE"
 
-	req, err := service.c.newRequest(method, path, nil, nil)
+	req, err := service.c.newRequest(ctx, method, path, nil, nil)
 	if err != nil {
 		return err
 	}
@@ -98,7 +89,7 @@ func (service *LocalesService) Delete(spaceID string, locale *Locale) error {
 }
 
 // Upsert updates or creates a new locale entity
-func (service *LocalesService) Upsert(spaceID string, locale *Locale) error {
+func (service *LocalesService) Upsert(ctx context.Context, spaceID string, locale *Locale) error {
 	bytesArray, err := json.Marshal(locale)
 	if err != nil {
 		return err
@@ -108,14 +99,14 @@ func (service *LocalesService) Upsert(spaceID string, locale *Locale) error {
 	var method string
 
 	if locale.Sys != nil && locale.Sys.CreatedAt != "" {
-		path = fmt.Sprintf("/spaces/%s/locales/%s", spaceID, locale.Sys.ID)
+		path = fmt.Sprintf("/spaces/%s/environments/%s/locales/%s", spaceID, service.c.environment(ctx), locale.Sys.ID)
 		method = "PUT"
 	} else {
-		path = fmt.Sprintf("/spaces/%s/locales", spaceID)
+		path = fmt.Sprintf("/spaces/%s/environments/%s/locales", spaceID, service.c.environment(ctx))
 		method = "POST"
 	}
 
-	req, err := service.c.newRequest(method, path, nil, bytes.NewReader(bytesArray))
+	req, err := service.c.newRequest(ctx, method, path, nil, bytes.NewReader(bytesArray))
 	if err != nil {
 		return err
 	}
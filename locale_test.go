@@ -1,6 +1,7 @@
 package contentful
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -16,7 +17,7 @@ func TestLocalesService_List(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "GET")
-		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/locales")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/master/locales")
 
 		checkHeaders(r, assertions)
 
@@ -30,9 +31,9 @@ func TestLocalesService_List(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	collection, err := cma.Locales.List(spaceID).Next()
+	collection, err := cma.Locales.List(spaceID).Next(context.Background())
 	assertions.Nil(err)
 	locale := collection.ToLocale()
 	assertions.Equal("34N35DoyUQAtaKwWTgZs34", locale[0].Sys.ID)
@@ -44,7 +45,7 @@ func TestLocalesService_Get(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "GET")
-		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/locales/4aGeQYgByqQFJtToAOh2JJ")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/master/locales/4aGeQYgByqQFJtToAOh2JJ")
 
 		checkHeaders(r, assertions)
 
@@ -58,9 +59,9 @@ func TestLocalesService_Get(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	locale, err := cma.Locales.Get(spaceID, "4aGeQYgByqQFJtToAOh2JJ")
+	locale, err := cma.Locales.Get(context.Background(), spaceID, "4aGeQYgByqQFJtToAOh2JJ")
 	assertions.Nil(err)
 	assertions.Equal("U.S. English", locale.Name)
 	assertions.Equal("en-US", locale.Code)
@@ -72,7 +73,7 @@ func TestLocalesService_Get_2(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "GET")
-		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/locales/4aGeQYgByqQFJtToAOh2JJ")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/master/locales/4aGeQYgByqQFJtToAOh2JJ")
 
 		checkHeaders(r, assertions)
 
@@ -86,9 +87,9 @@ func TestLocalesService_Get_2(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	_, err = cma.Locales.Get(spaceID, "4aGeQYgByqQFJtToAOh2JJ")
+	_, err = cma.Locales.Get(context.Background(), spaceID, "4aGeQYgByqQFJtToAOh2JJ")
 	assertions.NotNil(err)
 }
 
@@ -98,7 +99,7 @@ func TestLocalesService_Upsert_Create(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "POST")
-		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/locales")
+		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/environments/master/locales")
 
 		checkHeaders(r, assertions)
 
@@ -118,14 +119,14 @@ func TestLocalesService_Upsert_Create(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	locale := &Locale{
 		Name: "German (Austria)",
 		Code: "de-AT",
 	}
 
-	err = cma.Locales.Upsert(spaceID, locale)
+	err = cma.Locales.Upsert(context.Background(), spaceID, locale)
 	assertions.Nil(err)
 }
 
@@ -135,7 +136,7 @@ func TestLocalesService_Upsert_Update(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "PUT")
-		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/locales/4aGeQYgByqQFJtToAOh2JJ")
+		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/environments/master/locales/4aGeQYgByqQFJtToAOh2JJ")
 
 		checkHeaders(r, assertions)
 
@@ -155,7 +156,7 @@ func TestLocalesService_Upsert_Update(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	locale, err := localeFromTestData("locale_1.json")
 	assertions.Nil(err)
@@ -163,7 +164,7 @@ func TestLocalesService_Upsert_Update(t *testing.T) {
 	locale.Name = "modified-name"
 	locale.Code = "modified-code"
 
-	err = cma.Locales.Upsert(spaceID, locale)
+	err = cma.Locales.Upsert(context.Background(), spaceID, locale)
 	assertions.Nil(err)
 }
 
@@ -173,7 +174,7 @@ func TestLocalesService_Delete(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "DELETE")
-		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/locales/4aGeQYgByqQFJtToAOh2JJ")
+		assertions.Equal(r.RequestURI, "/spaces/"+spaceID+"/environments/master/locales/4aGeQYgByqQFJtToAOh2JJ")
 		checkHeaders(r, assertions)
 
 		w.WriteHeader(200)
@@ -185,13 +186,13 @@ func TestLocalesService_Delete(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	// test locale
 	locale, err := localeFromTestData("locale_1.json")
 	assertions.Nil(err)
 
 	// delete locale
-	err = cma.Locales.Delete(spaceID, locale)
+	err = cma.Locales.Delete(context.Background(), spaceID, locale)
 	assertions.Nil(err)
 }
@@ -1,6 +1,7 @@
 package contentful
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -30,9 +31,9 @@ func TestMembershipsService_List(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	collection, err := cma.Memberships.List(spaceID).Next()
+	collection, err := cma.Memberships.List(spaceID).Next(context.Background())
 	assertions.Nil(err)
 	membership := collection.ToMembership()
 	assertions.Equal(2, len(membership))
@@ -59,9 +60,9 @@ func TestMembershipsService_Get(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	membership, err := cma.Memberships.Get(spaceID, "0xWanD4AZI2AR35wW9q51n")
+	membership, err := cma.Memberships.Get(context.Background(), spaceID, "0xWanD4AZI2AR35wW9q51n")
 	assertions.Nil(err)
 	assertions.Equal("0xWanD4AZI2AR35wW9q51n", membership.Sys.ID)
 }
@@ -86,10 +87,11 @@ func TestMembershipsService_Get_2(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	_, err = cma.Memberships.Get(spaceID, "0xWanD4AZI2AR35wW9q51n")
-	assertions.Nil(err)
+	membership, err := cma.Memberships.Get(context.Background(), spaceID, "0xWanD4AZI2AR35wW9q51n")
+	assertions.NotNil(err)
+	assertions.Nil(membership)
 }
 
 func TestMembershipsService_Upsert_Create(t *testing.T) {
@@ -121,7 +123,7 @@ func TestMembershipsService_Upsert_Create(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	membership := &Membership{
 		Admin: true,
@@ -137,7 +139,7 @@ func TestMembershipsService_Upsert_Create(t *testing.T) {
 		Email: "johndoe@nonexistent.com",
 	}
 
-	err = cma.Memberships.Upsert(spaceID, membership)
+	err = cma.Memberships.Upsert(context.Background(), spaceID, membership)
 	assertions.Nil(err)
 }
 
@@ -168,14 +170,14 @@ func TestMembershipsService_Upsert_Update(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	membership, err := membershipFromTestData("membership_1.json")
 	assertions.Nil(err)
 
 	membership.Email = "editedmail@examplemail.com"
 
-	err = cma.Memberships.Upsert(spaceID, membership)
+	err = cma.Memberships.Upsert(context.Background(), spaceID, membership)
 	assertions.Nil(err)
 }
 
@@ -197,13 +199,13 @@ func TestMembershipsService_Delete(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	// test role
 	membership, err := membershipFromTestData("membership_1.json")
 	assertions.Nil(err)
 
 	// delete role
-	err = cma.Memberships.Delete(spaceID, membership.Sys.ID)
+	err = cma.Memberships.Delete(context.Background(), spaceID, membership.Sys.ID)
 	assertions.Nil(err)
 }
@@ -0,0 +1,822 @@
+package contentful
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Migration is an ordered list of changes of the content model and of the
+// entries of an environment, in the spirit of contentful-migration. Steps are
+// declared with the builder methods and executed in order by Run.
+//
+//	m := contentful.NewMigration()
+//	post := m.CreateContentType("post").Name("Post").DisplayField("title")
+//	post.CreateField("title").Name("Title").Type(contentful.FieldTypeSymbol).Required(true)
+//	m.EditContentType("author").DeleteField("legacy")
+//	log, err := m.Run(ctx, cma, spaceID)
+type Migration struct {
+	steps []migrationStep
+}
+
+// MigrationLog records the steps of a migration run
+type MigrationLog struct {
+	Steps []*MigrationLogStep
+}
+
+// MigrationLogStep is a step of a migration run and what it changed
+type MigrationLogStep struct {
+	Description string
+	Details     []string
+	Duration    time.Duration
+	Err         error
+}
+
+// migrationStep is a step of a migration
+type migrationStep interface {
+	describe() string
+	run(ctx context.Context, run *migrationRun, step *MigrationLogStep) error
+}
+
+// migrationRun is the environment a migration runs against
+type migrationRun struct {
+	client  *Client
+	spaceID string
+	locales []*Locale
+}
+
+// NewMigration returns an empty migration
+func NewMigration() *Migration {
+	return &Migration{}
+}
+
+// Run executes the steps in order in the space and the environment of the
+// client, and stops at the first failing step. The log holds the executed
+// steps, including the failing one.
+func (m *Migration) Run(ctx context.Context, c *Client, spaceID string) (*MigrationLog, error) {
+	log := &MigrationLog{}
+	run := &migrationRun{client: c, spaceID: spaceID}
+
+	for _, s := range m.steps {
+		step := &MigrationLogStep{Description: s.describe()}
+		log.Steps = append(log.Steps, step)
+
+		start := time.Now()
+		step.Err = s.run(ctx, run, step)
+		step.Duration = time.Since(start)
+
+		if step.Err != nil {
+			return log, fmt.Errorf("contentful: migration step %q failed: %w", step.Description, step.Err)
+		}
+	}
+
+	return log, nil
+}
+
+// Describe lists the steps of the migration without running it
+func (m *Migration) Describe() []string {
+	descriptions := make([]string, len(m.steps))
+	for i, s := range m.steps {
+		descriptions[i] = s.describe()
+	}
+
+	return descriptions
+}
+
+// String formats the log, one line per step followed by its details
+func (log *MigrationLog) String() string {
+	msg := strings.Builder{}
+	for _, step := range log.Steps {
+		status := "done"
+		if step.Err != nil {
+			status = "failed: " + step.Err.Error()
+		}
+
+		msg.WriteString(fmt.Sprintf("%s (%s)\n", step.Description, status))
+		for _, detail := range step.Details {
+			msg.WriteString("  - " + detail + "\n")
+		}
+	}
+
+	return msg.String()
+}
+
+// environmentLocales returns the locales of the environment, fetched once per run
+func (run *migrationRun) environmentLocales(ctx context.Context) ([]*Locale, error) {
+	if run.locales != nil {
+		return run.locales, nil
+	}
+
+	locales, err := All[*Locale](ctx, run.client.Locales.List(run.spaceID))
+	if err != nil {
+		return nil, err
+	}
+
+	run.locales = locales
+
+	return locales, nil
+}
+
+// ContentTypeMigration creates or edits a content type. The changes are
+// applied in the order they are declared, then the content type is saved
+// and activated once.
+type ContentTypeMigration struct {
+	id           string
+	create       bool
+	name         *string
+	description  *string
+	displayField *string
+	fields       []func(ct *ContentType, step *MigrationLogStep) error
+	deleted      []string
+	controls     []*Controls
+}
+
+// CreateContentType declares a new content type
+func (m *Migration) CreateContentType(id string) *ContentTypeMigration {
+	ct := &ContentTypeMigration{id: id, create: true}
+	m.steps = append(m.steps, ct)
+
+	return ct
+}
+
+// EditContentType declares changes of an existing content type
+func (m *Migration) EditContentType(id string) *ContentTypeMigration {
+	ct := &ContentTypeMigration{id: id}
+	m.steps = append(m.steps, ct)
+
+	return ct
+}
+
+// Name sets the name of the content type
+func (ct *ContentTypeMigration) Name(name string) *ContentTypeMigration {
+	ct.name = &name
+	return ct
+}
+
+// Description sets the description of the content type
+func (ct *ContentTypeMigration) Description(description string) *ContentTypeMigration {
+	ct.description = &description
+	return ct
+}
+
+// DisplayField sets the field used as the title of the entries
+func (ct *ContentTypeMigration) DisplayField(fieldID string) *ContentTypeMigration {
+	ct.displayField = &fieldID
+	return ct
+}
+
+// CreateField declares a new field, appended to the fields of the content type
+func (ct *ContentTypeMigration) CreateField(id string) *FieldMigration {
+	field := &FieldMigration{id: id}
+	ct.fields = append(ct.fields, func(contentType *ContentType, step *MigrationLogStep) error {
+		if fieldIndex(contentType, id) >= 0 {
+			return fmt.Errorf("field %s already exists", id)
+		}
+
+		created := &Field{ID: id}
+		field.apply(created)
+		contentType.Fields = append(contentType.Fields, created)
+		step.Details = append(step.Details, "Create field "+id)
+
+		return nil
+	})
+
+	return field
+}
+
+// EditField declares changes of an existing field
+func (ct *ContentTypeMigration) EditField(id string) *FieldMigration {
+	field := &FieldMigration{id: id}
+	ct.fields = append(ct.fields, func(contentType *ContentType, step *MigrationLogStep) error {
+		i := fieldIndex(contentType, id)
+		if i < 0 {
+			return fmt.Errorf("field %s does not exist", id)
+		}
+
+		field.apply(contentType.Fields[i])
+		step.Details = append(step.Details, "Edit field "+id)
+
+		return nil
+	})
+
+	return field
+}
+
+// DeleteField deletes a field. Like Contentful requires, the field is omitted
+// and the content type activated before the field is deleted.
+func (ct *ContentTypeMigration) DeleteField(id string) *ContentTypeMigration {
+	ct.fields = append(ct.fields, func(contentType *ContentType, step *MigrationLogStep) error {
+		i := fieldIndex(contentType, id)
+		if i < 0 {
+			return fmt.Errorf("field %s does not exist", id)
+		}
+
+		contentType.Fields[i].Omitted = true
+		step.Details = append(step.Details, "Delete field "+id)
+
+		return nil
+	})
+	ct.deleted = append(ct.deleted, id)
+
+	return ct
+}
+
+// ChangeFieldID changes the id of a field, the values of the entries are kept
+func (ct *ContentTypeMigration) ChangeFieldID(id, newID string) *ContentTypeMigration {
+	ct.fields = append(ct.fields, func(contentType *ContentType, step *MigrationLogStep) error {
+		i := fieldIndex(contentType, id)
+		if i < 0 {
+			return fmt.Errorf("field %s does not exist", id)
+		}
+
+		if fieldIndex(contentType, newID) >= 0 {
+			return fmt.Errorf("field %s already exists", newID)
+		}
+
+		contentType.Fields[i].NewID = newID
+		if contentType.DisplayField == id {
+			contentType.DisplayField = newID
+		}
+		step.Details = append(step.Details, fmt.Sprintf("Change field id %s to %s", id, newID))
+
+		return nil
+	})
+
+	return ct
+}
+
+// MoveField declares the move of a field, completed by one of the methods of FieldMove
+func (ct *ContentTypeMigration) MoveField(id string) *FieldMove {
+	return &FieldMove{ct: ct, id: id}
+}
+
+// ChangeFieldControl changes the widget used to edit a field
+func (ct *ContentTypeMigration) ChangeFieldControl(fieldID, widgetNamespace, widgetID string, settings map[string]string) *ContentTypeMigration {
+	ct.controls = append(ct.controls, &Controls{
+		FieldID:         fieldID,
+		WidgetNameSpace: widgetNamespace,
+		WidgetID:        widgetID,
+		Settings:        settings,
+	})
+
+	return ct
+}
+
+func (ct *ContentTypeMigration) describe() string {
+	if ct.create {
+		return "Create content type " + ct.id
+	}
+
+	return "Edit content type " + ct.id
+}
+
+func (ct *ContentTypeMigration) run(ctx context.Context, run *migrationRun, step *MigrationLogStep) error {
+	service := run.client.ContentTypes
+
+	contentType, err := service.Get(ctx, run.spaceID, ct.id)
+	var notFound NotFoundError
+	switch {
+	case ct.create && err == nil:
+		return fmt.Errorf("content type %s already exists", ct.id)
+	case ct.create && errors.As(err, &notFound):
+		contentType = &ContentType{Sys: &Sys{ID: ct.id}}
+	case err != nil:
+		return err
+	}
+
+	if ct.name != nil {
+		contentType.Name = *ct.name
+	}
+
+	if ct.description != nil {
+		contentType.Description = *ct.description
+	}
+
+	if ct.displayField != nil {
+		contentType.DisplayField = *ct.displayField
+	}
+
+	for _, change := range ct.fields {
+		if err := change(contentType, step); err != nil {
+			return err
+		}
+	}
+
+	if err := ct.save(ctx, run, contentType); err != nil {
+		return err
+	}
+
+	// the omitted fields are deleted once the content type is activated
+	if len(ct.deleted) > 0 {
+		for _, id := range ct.deleted {
+			if i := fieldIndex(contentType, id); i >= 0 {
+				contentType.Fields[i].Deleted = true
+			}
+		}
+
+		if err := ct.save(ctx, run, contentType); err != nil {
+			return err
+		}
+	}
+
+	if len(ct.controls) == 0 {
+		return nil
+	}
+
+	editorInterface, err := run.client.EditorInterfaces.Get(ctx, run.spaceID, ct.id)
+	if err != nil {
+		return err
+	}
+
+	for _, control := range ct.controls {
+		replaced := false
+		for i := range editorInterface.Controls {
+			if editorInterface.Controls[i].FieldID == control.FieldID {
+				editorInterface.Controls[i] = *control
+				replaced = true
+			}
+		}
+
+		if !replaced {
+			editorInterface.Controls = append(editorInterface.Controls, *control)
+		}
+
+		step.Details = append(step.Details, fmt.Sprintf("Change control of field %s to %s", control.FieldID, control.WidgetID))
+	}
+
+	return run.client.EditorInterfaces.Update(ctx, run.spaceID, ct.id, editorInterface)
+}
+
+// save upserts and activates the content type
+func (ct *ContentTypeMigration) save(ctx context.Context, run *migrationRun, contentType *ContentType) error {
+	if err := run.client.ContentTypes.Upsert(ctx, run.spaceID, contentType); err != nil {
+		return err
+	}
+
+	return run.client.ContentTypes.Activate(ctx, run.spaceID, contentType)
+}
+
+// FieldMigration declares the properties of a created or edited field
+type FieldMigration struct {
+	id      string
+	changes []func(field *Field)
+}
+
+// Name sets the name of the field
+func (f *FieldMigration) Name(name string) *FieldMigration {
+	return f.change(func(field *Field) { field.Name = name })
+}
+
+// Type sets the type of the field, one of the FieldType constants
+func (f *FieldMigration) Type(fieldType string) *FieldMigration {
+	return f.change(func(field *Field) { field.Type = fieldType })
+}
+
+// LinkType sets the link type of a Link field, Entry or Asset
+func (f *FieldMigration) LinkType(linkType string) *FieldMigration {
+	return f.change(func(field *Field) { field.LinkType = linkType })
+}
+
+// Items sets the type of the items of an Array field
+func (f *FieldMigration) Items(items *FieldTypeArrayItem) *FieldMigration {
+	return f.change(func(field *Field) { field.Items = items })
+}
+
+// Required sets whether the field is required to publish entries
+func (f *FieldMigration) Required(required bool) *FieldMigration {
+	return f.change(func(field *Field) { field.Required = required })
+}
+
+// Localized sets whether the field has a value per locale
+func (f *FieldMigration) Localized(localized bool) *FieldMigration {
+	return f.change(func(field *Field) { field.Localized = localized })
+}
+
+// Disabled sets whether the field is read only in the web app
+func (f *FieldMigration) Disabled(disabled bool) *FieldMigration {
+	return f.change(func(field *Field) { field.Disabled = disabled })
+}
+
+// Omitted sets whether the field is omitted from the api responses
+func (f *FieldMigration) Omitted(omitted bool) *FieldMigration {
+	return f.change(func(field *Field) { field.Omitted = omitted })
+}
+
+// Validations replaces the validations of the field
+func (f *FieldMigration) Validations(validations ...FieldValidation) *FieldMigration {
+	return f.change(func(field *Field) { field.Validations = validations })
+}
+
+func (f *FieldMigration) change(change func(field *Field)) *FieldMigration {
+	f.changes = append(f.changes, change)
+	return f
+}
+
+func (f *FieldMigration) apply(field *Field) {
+	for _, change := range f.changes {
+		change(field)
+	}
+}
+
+// FieldMove moves a field of a content type
+type FieldMove struct {
+	ct *ContentTypeMigration
+	id string
+}
+
+// ToTheTop moves the field first
+func (move *FieldMove) ToTheTop() *ContentTypeMigration {
+	return move.to("to the top", "", func(fields []*Field, _ int) int { return 0 })
+}
+
+// ToTheBottom moves the field last
+func (move *FieldMove) ToTheBottom() *ContentTypeMigration {
+	return move.to("to the bottom", "", func(fields []*Field, _ int) int { return len(fields) })
+}
+
+// BeforeField moves the field before another one
+func (move *FieldMove) BeforeField(id string) *ContentTypeMigration {
+	return move.to("before "+id, id, func(_ []*Field, other int) int { return other })
+}
+
+// AfterField moves the field after another one
+func (move *FieldMove) AfterField(id string) *ContentTypeMigration {
+	return move.to("after "+id, id, func(_ []*Field, other int) int { return other + 1 })
+}
+
+// to moves the field at the position computed from the remaining fields and
+// the position of the other field of the move, if any
+func (move *FieldMove) to(where, otherID string, position func(fields []*Field, other int) int) *ContentTypeMigration {
+	move.ct.fields = append(move.ct.fields, func(contentType *ContentType, step *MigrationLogStep) error {
+		i := fieldIndex(contentType, move.id)
+		if i < 0 {
+			return fmt.Errorf("field %s does not exist", move.id)
+		}
+
+		field := contentType.Fields[i]
+		fields := append(append([]*Field{}, contentType.Fields[:i]...), contentType.Fields[i+1:]...)
+
+		other := -1
+		if otherID != "" {
+			for j, f := range fields {
+				if f.ID == otherID {
+					other = j
+				}
+			}
+
+			if other < 0 {
+				return fmt.Errorf("field %s does not exist", otherID)
+			}
+		}
+
+		at := position(fields, other)
+		fields = append(fields[:at], append([]*Field{field}, fields[at:]...)...)
+		contentType.Fields = fields
+		step.Details = append(step.Details, fmt.Sprintf("Move field %s %s", move.id, where))
+
+		return nil
+	})
+
+	return move.ct
+}
+
+// DeleteContentType deletes a content type, which must have no entries
+func (m *Migration) DeleteContentType(id string) {
+	m.steps = append(m.steps, &deleteContentTypeStep{id: id})
+}
+
+type deleteContentTypeStep struct {
+	id string
+}
+
+func (s *deleteContentTypeStep) describe() string {
+	return "Delete content type " + s.id
+}
+
+func (s *deleteContentTypeStep) run(ctx context.Context, run *migrationRun, _ *MigrationLogStep) error {
+	service := run.client.ContentTypes
+
+	contentType, err := service.Get(ctx, run.spaceID, s.id)
+	if err != nil {
+		return err
+	}
+
+	if published(contentType.Sys) {
+		if err := service.Deactivate(ctx, run.spaceID, contentType); err != nil {
+			return err
+		}
+	}
+
+	return service.Delete(ctx, run.spaceID, contentType)
+}
+
+// EntryTransform is the transformation of the entries of a content type
+type EntryTransform struct {
+	// ContentType of the transformed entries
+	ContentType string
+
+	// From lists the fields passed to Transform
+	From []string
+
+	// To lists the fields set from the result of Transform
+	To []string
+
+	// Transform is called for each entry and locale with the values of the
+	// From fields in the locale. The returned values of the To fields are set
+	// in the locale, returning nil leaves the entry unchanged in the locale.
+	Transform func(from map[string]interface{}, locale string) (map[string]interface{}, error)
+
+	// ShouldPublish publishes the changed entries which were published
+	ShouldPublish bool
+}
+
+// TransformEntries transforms the values of the entries of a content type
+func (m *Migration) TransformEntries(transform *EntryTransform) {
+	m.steps = append(m.steps, &transformEntriesStep{transform})
+}
+
+type transformEntriesStep struct {
+	*EntryTransform
+}
+
+func (s *transformEntriesStep) describe() string {
+	return "Transform entries of " + s.ContentType
+}
+
+func (s *transformEntriesStep) run(ctx context.Context, run *migrationRun, step *MigrationLogStep) error {
+	locales, err := run.environmentLocales(ctx)
+	if err != nil {
+		return err
+	}
+
+	updated := 0
+	it := Iterate[*Entry](ctx, run.client.Entries.ListWithContentType(run.spaceID, s.ContentType).WithKeysetPagination())
+	for it.Next() {
+		entry := it.Value()
+		changed := false
+
+		for _, locale := range locales {
+			to, err := s.Transform(localizedFields(entry, s.From, locale.Code), locale.Code)
+			if err != nil {
+				return fmt.Errorf("entry %s: %w", entry.Sys.ID, err)
+			}
+
+			for _, id := range s.To {
+				if value, ok := to[id]; ok {
+					setLocalizedField(entry, id, locale.Code, value)
+					changed = true
+				}
+			}
+		}
+
+		if !changed {
+			continue
+		}
+
+		if err := saveEntry(ctx, run, entry, s.ShouldPublish); err != nil {
+			return fmt.Errorf("entry %s: %w", entry.Sys.ID, err)
+		}
+		updated++
+	}
+
+	if err := it.Err(); err != nil {
+		return err
+	}
+
+	step.Details = append(step.Details, fmt.Sprintf("Update %d entries", updated))
+
+	return nil
+}
+
+// EntryDerivation creates entries of a content type from the entries of
+// another one, and links them from the source entries
+type EntryDerivation struct {
+	// ContentType of the source entries
+	ContentType string
+
+	// From lists the fields of the source entries passed to IdentityKey and Derive
+	From []string
+
+	// ToReferenceField is the field of the source entries linking to the derived entry
+	ToReferenceField string
+
+	// DerivedContentType is the content type of the derived entries
+	DerivedContentType string
+
+	// DerivedFields lists the fields of the derived entries set from the result of Derive
+	DerivedFields []string
+
+	// IdentityKey returns the id of the derived entry of a source entry, source
+	// entries with the same key link to the same derived entry
+	IdentityKey func(from map[string]interface{}) (string, error)
+
+	// Derive returns the values of the derived fields in a locale, given the
+	// values of the From fields of the source entry in that locale
+	Derive func(from map[string]interface{}, locale string) (map[string]interface{}, error)
+
+	// ShouldPublish publishes the derived entries and the source entries which were published
+	ShouldPublish bool
+}
+
+// DeriveLinkedEntries creates entries derived from the entries of a content type
+func (m *Migration) DeriveLinkedEntries(derivation *EntryDerivation) {
+	m.steps = append(m.steps, &deriveEntriesStep{derivation})
+}
+
+type deriveEntriesStep struct {
+	*EntryDerivation
+}
+
+func (s *deriveEntriesStep) describe() string {
+	return fmt.Sprintf("Derive entries of %s from %s", s.DerivedContentType, s.ContentType)
+}
+
+func (s *deriveEntriesStep) run(ctx context.Context, run *migrationRun, step *MigrationLogStep) error {
+	locales, err := run.environmentLocales(ctx)
+	if err != nil {
+		return err
+	}
+
+	contentType, err := run.client.ContentTypes.Get(ctx, run.spaceID, s.ContentType)
+	if err != nil {
+		return err
+	}
+
+	referenceLocales := locales
+	if i := fieldIndex(contentType, s.ToReferenceField); i < 0 {
+		return fmt.Errorf("field %s does not exist", s.ToReferenceField)
+	} else if !contentType.Fields[i].Localized {
+		referenceLocales = defaultLocale(locales)
+	}
+
+	derived := map[string]bool{}
+	created, linked := 0, 0
+
+	it := Iterate[*Entry](ctx, run.client.Entries.ListWithContentType(run.spaceID, s.ContentType).WithKeysetPagination())
+	for it.Next() {
+		entry := it.Value()
+
+		key, err := s.IdentityKey(localizedFields(entry, s.From, defaultLocale(locales)[0].Code))
+		if err != nil {
+			return fmt.Errorf("entry %s: %w", entry.Sys.ID, err)
+		}
+
+		if !derived[key] {
+			isNew, err := s.derive(ctx, run, entry, key, locales)
+			if err != nil {
+				return fmt.Errorf("entry %s: %w", entry.Sys.ID, err)
+			}
+
+			derived[key] = true
+			if isNew {
+				created++
+			}
+		}
+
+		for _, locale := range referenceLocales {
+			setLocalizedField(entry, s.ToReferenceField, locale.Code, map[string]interface{}{
+				"sys": map[string]interface{}{"type": "Link", "linkType": "Entry", "id": key},
+			})
+		}
+
+		if err := saveEntry(ctx, run, entry, s.ShouldPublish); err != nil {
+			return fmt.Errorf("entry %s: %w", entry.Sys.ID, err)
+		}
+		linked++
+	}
+
+	if err := it.Err(); err != nil {
+		return err
+	}
+
+	step.Details = append(step.Details, fmt.Sprintf("Create %d entries, link %d entries", created, linked))
+
+	return nil
+}
+
+// derive creates the derived entry with the given id, unless it exists
+func (s *deriveEntriesStep) derive(ctx context.Context, run *migrationRun, source *Entry, id string, locales []*Locale) (bool, error) {
+	if existing, err := findEntry(ctx, run.client, run.spaceID, id); err != nil || existing != nil {
+		return false, err
+	}
+
+	entry := &Entry{Sys: &Sys{ID: id}, Fields: map[string]interface{}{}}
+	for _, locale := range locales {
+		values, err := s.Derive(localizedFields(source, s.From, locale.Code), locale.Code)
+		if err != nil {
+			return false, err
+		}
+
+		for _, field := range s.DerivedFields {
+			if value, ok := values[field]; ok {
+				setLocalizedField(entry, field, locale.Code, value)
+			}
+		}
+	}
+
+	if err := run.client.Entries.Upsert(ctx, run.spaceID, s.DerivedContentType, entry); err != nil {
+		return false, err
+	}
+
+	if s.ShouldPublish {
+		if err := run.client.Entries.Publish(ctx, run.spaceID, entry); err != nil {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// saveEntry upserts the entry, and publishes it again when it was published
+func saveEntry(ctx context.Context, run *migrationRun, entry *Entry, publish bool) error {
+	wasPublished := published(entry.Sys)
+
+	contentTypeID := ""
+	if entry.Sys.ContentType != nil && entry.Sys.ContentType.Sys != nil {
+		contentTypeID = entry.Sys.ContentType.Sys.ID
+	}
+
+	if err := run.client.Entries.Upsert(ctx, run.spaceID, contentTypeID, entry); err != nil {
+		return err
+	}
+
+	if !publish || !wasPublished {
+		return nil
+	}
+
+	return run.client.Entries.Publish(ctx, run.spaceID, entry)
+}
+
+// findEntry returns the entry with the given id, nil when there is no such
+// entry. Unlike Entries.Get, the error of a missing entry is not swallowed.
+func findEntry(ctx context.Context, c *Client, spaceID, id string) (*Entry, error) {
+	col := c.Entries.List(spaceID)
+	col.Query.Equal("sys.id", id).Limit(1)
+	if _, err := col.Next(ctx); err != nil {
+		return nil, err
+	}
+
+	entries, err := DecodeItems[*Entry](col.Items)
+	if err != nil || len(entries) == 0 {
+		return nil, err
+	}
+
+	return entries[0], nil
+}
+
+// localizedFields returns the values of the given fields of an entry in a locale
+func localizedFields(entry *Entry, ids []string, locale string) map[string]interface{} {
+	values := map[string]interface{}{}
+	for _, id := range ids {
+		if localized, ok := entry.Fields[id].(map[string]interface{}); ok {
+			if value, ok := localized[locale]; ok {
+				values[id] = value
+			}
+		}
+	}
+
+	return values
+}
+
+// setLocalizedField sets the value of a field of an entry in a locale
+func setLocalizedField(entry *Entry, id, locale string, value interface{}) {
+	if entry.Fields == nil {
+		entry.Fields = map[string]interface{}{}
+	}
+
+	localized, ok := entry.Fields[id].(map[string]interface{})
+	if !ok {
+		localized = map[string]interface{}{}
+		entry.Fields[id] = localized
+	}
+
+	localized[locale] = value
+}
+
+// defaultLocale returns the default locale, or the first one
+func defaultLocale(locales []*Locale) []*Locale {
+	for _, locale := range locales {
+		if locale.Default {
+			return []*Locale{locale}
+		}
+	}
+
+	if len(locales) == 0 {
+		return []*Locale{{Code: "en-US"}}
+	}
+
+	return locales[:1]
+}
+
+// fieldIndex returns the position of a field, -1 when the content type has no such field
+func fieldIndex(contentType *ContentType, id string) int {
+	for i, field := range contentType.Fields {
+		if field.ID == id {
+			return i
+		}
+	}
+
+	return -1
+}
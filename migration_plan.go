@@ -0,0 +1,265 @@
+package contentful
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+const (
+	// PlanUpsertContentType saves a content type
+	PlanUpsertContentType = "upsertContentType"
+
+	// PlanActivateContentType activates a content type
+	PlanActivateContentType = "activateContentType"
+
+	// PlanDeactivateContentType deactivates a content type
+	PlanDeactivateContentType = "deactivateContentType"
+
+	// PlanDeleteContentType deletes a content type
+	PlanDeleteContentType = "deleteContentType"
+
+	// PlanUpdateEditorInterface updates the controls of an editor interface
+	PlanUpdateEditorInterface = "updateEditorInterface"
+)
+
+// MigrationPlan is an ordered list of operations bringing the content types
+// and editor interfaces of an environment in line with another one. It is
+// built from the diff from the environment to change to the environment it
+// is brought in line with:
+//
+//	diff, err := cma.Space(spaceID).DiffEnvironments(ctx, "master", "staging")
+//	plan := contentful.NewMigrationPlan(diff)
+//	fmt.Print(plan)
+//	err = cma.Space(spaceID).Environment("master").ApplyPlan(ctx, plan)
+//
+// Content types are created and changed first, then the removed fields,
+// which were omitted by the first change, are deleted, then the editor
+// interfaces are updated and the removed content types deleted. Locales are
+// not part of the plan.
+type MigrationPlan struct {
+	Operations []*PlanOperation
+}
+
+// PlanOperation is an operation of a migration plan
+type PlanOperation struct {
+	// Kind is one of the Plan constants
+	Kind string
+
+	// ContentTypeID is the content type the operation applies to
+	ContentTypeID string
+
+	// ContentType is the content type saved, activated, deactivated or
+	// deleted. The operations of a content type share it, so that each one
+	// sees the version returned by the previous one.
+	ContentType *ContentType
+
+	// DeletedFields lists the fields deleted by an upsert, which were
+	// omitted by a previous upsert
+	DeletedFields []string
+
+	// Controls are the controls set on the editor interface
+	Controls []Controls
+
+	// Description describes the operation
+	Description string
+}
+
+// NewMigrationPlan returns the operations changing the source of the diff
+// into its target. Changes of field types are planned like any other change
+// and are rejected by the api when the plan is applied.
+func NewMigrationPlan(diff *ContentModelDiff) *MigrationPlan {
+	var changes, deletions, editorInterfaces, removals []*PlanOperation
+
+	for _, d := range diff.ContentTypes {
+		switch d.Change {
+		case DiffRemoved:
+			ct := &ContentType{Sys: copySys(d.From.Sys)}
+			if published(d.From.Sys) {
+				removals = append(removals, &PlanOperation{
+					Kind:          PlanDeactivateContentType,
+					ContentTypeID: d.ID,
+					ContentType:   ct,
+					Description:   "Deactivate content type " + d.ID,
+				})
+			}
+
+			removals = append(removals, &PlanOperation{
+				Kind:          PlanDeleteContentType,
+				ContentTypeID: d.ID,
+				ContentType:   ct,
+				Description:   "Delete content type " + d.ID,
+			})
+
+		case DiffAdded:
+			ct := cloneContentType(d.To)
+			ct.Sys = &Sys{ID: d.ID}
+			changes = append(changes, upsertAndActivate(ct, "Create content type "+d.ID, nil)...)
+
+			if d.ToEditorInterface != nil {
+				editorInterfaces = append(editorInterfaces, updateEditorInterface(d.ID, d.ToEditorInterface))
+			}
+
+		case DiffChanged:
+			if len(d.Properties) > 0 || len(d.Fields) > 0 {
+				ct := cloneContentType(d.To)
+				ct.Sys = copySys(d.From.Sys)
+
+				var summary, removed []string
+				for _, field := range d.Fields {
+					summary = append(summary, fmt.Sprintf("%s field %s", field.Change, field.ID))
+					if field.Change == DiffRemoved {
+						omitted := *field.From
+						omitted.Omitted = true
+						ct.Fields = append(ct.Fields, &omitted)
+						removed = append(removed, field.ID)
+					}
+				}
+
+				description := "Update content type " + d.ID
+				if len(summary) > 0 {
+					description += " (" + strings.Join(summary, ", ") + ")"
+				}
+				changes = append(changes, upsertAndActivate(ct, description, nil)...)
+
+				if len(removed) > 0 {
+					description := fmt.Sprintf("Delete fields %s of content type %s", strings.Join(removed, ", "), d.ID)
+					deletions = append(deletions, upsertAndActivate(ct, description, removed)...)
+				}
+			}
+
+			if len(d.Controls) > 0 && d.ToEditorInterface != nil {
+				editorInterfaces = append(editorInterfaces, updateEditorInterface(d.ID, d.ToEditorInterface))
+			}
+		}
+	}
+
+	plan := &MigrationPlan{}
+	for _, operations := range [][]*PlanOperation{changes, deletions, editorInterfaces, removals} {
+		plan.Operations = append(plan.Operations, operations...)
+	}
+
+	return plan
+}
+
+// Empty reports whether the plan has no operation
+func (plan *MigrationPlan) Empty() bool {
+	return len(plan.Operations) == 0
+}
+
+// String formats the plan for review, one numbered line per operation
+func (plan *MigrationPlan) String() string {
+	if plan.Empty() {
+		return "Nothing to do\n"
+	}
+
+	msg := strings.Builder{}
+	for i, operation := range plan.Operations {
+		msg.WriteString(fmt.Sprintf("%d. %s: %s\n", i+1, operation.Kind, operation.Description))
+	}
+
+	return msg.String()
+}
+
+// Apply runs the operations in order in the environment of the client, and
+// stops at the first failing one
+func (plan *MigrationPlan) Apply(ctx context.Context, c *Client, spaceID string) error {
+	for i, operation := range plan.Operations {
+		if err := operation.apply(ctx, c, spaceID); err != nil {
+			return fmt.Errorf("contentful: plan operation %d, %s: %w", i+1, operation.Description, err)
+		}
+	}
+
+	return nil
+}
+
+func (operation *PlanOperation) apply(ctx context.Context, c *Client, spaceID string) error {
+	switch operation.Kind {
+	case PlanUpsertContentType:
+		for _, field := range operation.ContentType.Fields {
+			for _, id := range operation.DeletedFields {
+				if field.ID == id {
+					field.Deleted = true
+				}
+			}
+		}
+
+		return c.ContentTypes.Upsert(ctx, spaceID, operation.ContentType)
+
+	case PlanActivateContentType:
+		return c.ContentTypes.Activate(ctx, spaceID, operation.ContentType)
+
+	case PlanDeactivateContentType:
+		return c.ContentTypes.Deactivate(ctx, spaceID, operation.ContentType)
+
+	case PlanDeleteContentType:
+		return c.ContentTypes.Delete(ctx, spaceID, operation.ContentType)
+
+	case PlanUpdateEditorInterface:
+		// the editor interface of a new content type exists once it is
+		// activated, it is read for its current version
+		editorInterface, err := c.EditorInterfaces.Get(ctx, spaceID, operation.ContentTypeID)
+		if err != nil {
+			return err
+		}
+
+		editorInterface.Controls = operation.Controls
+
+		return c.EditorInterfaces.Update(ctx, spaceID, operation.ContentTypeID, editorInterface)
+	}
+
+	return fmt.Errorf("contentful: unknown operation %s", operation.Kind)
+}
+
+func upsertAndActivate(ct *ContentType, description string, deletedFields []string) []*PlanOperation {
+	return []*PlanOperation{
+		{
+			Kind:          PlanUpsertContentType,
+			ContentTypeID: ct.Sys.ID,
+			ContentType:   ct,
+			DeletedFields: deletedFields,
+			Description:   description,
+		},
+		{
+			Kind:          PlanActivateContentType,
+			ContentTypeID: ct.Sys.ID,
+			ContentType:   ct,
+			Description:   "Activate content type " + ct.Sys.ID,
+		},
+	}
+}
+
+func updateEditorInterface(contentTypeID string, editorInterface *EditorInterface) *PlanOperation {
+	var controls []string
+	for _, control := range editorInterface.Controls {
+		controls = append(controls, control.FieldID+"="+control.WidgetID)
+	}
+
+	return &PlanOperation{
+		Kind:          PlanUpdateEditorInterface,
+		ContentTypeID: contentTypeID,
+		Controls:      append([]Controls(nil), editorInterface.Controls...),
+		Description:   fmt.Sprintf("Update editor interface of %s (%s)", contentTypeID, strings.Join(controls, ", ")),
+	}
+}
+
+// cloneContentType returns a copy of a content type whose fields can be changed
+func cloneContentType(ct *ContentType) *ContentType {
+	clone := *ct
+	clone.Fields = make([]*Field, len(ct.Fields))
+	for i, field := range ct.Fields {
+		copied := *field
+		clone.Fields[i] = &copied
+	}
+
+	return &clone
+}
+
+// copySys returns a copy of the sys of a content type, keeping its id and version
+func copySys(sys *Sys) *Sys {
+	if sys == nil {
+		return &Sys{}
+	}
+
+	return &Sys{ID: sys.ID, Type: sys.Type, Version: sys.Version, PublishedVersion: sys.PublishedVersion}
+}
@@ -0,0 +1,97 @@
+package contentful
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMigrationPlan(t *testing.T) {
+	assertions := assert.New(t)
+
+	master := masterModel()
+	master.ContentTypes[0].Sys.Version = 7
+	master.ContentTypes[1].Sys.PublishedVersion = 3
+
+	plan := NewMigrationPlan(DiffContentModels(master, stagingModel()))
+	assertions.Equal(`1. upsertContentType: Update content type post (changed field title, added field slug, changed field body, removed field legacy)
+2. activateContentType: Activate content type post
+3. upsertContentType: Create content type tag
+4. activateContentType: Activate content type tag
+5. upsertContentType: Delete fields legacy of content type post
+6. activateContentType: Activate content type post
+7. updateEditorInterface: Update editor interface of post (title=singleLine, slug=slugEditor)
+8. deactivateContentType: Deactivate content type banner
+9. deleteContentType: Delete content type banner
+`, plan.String())
+
+	// the content type is changed at the version of the environment to change
+	post := plan.Operations[0].ContentType
+	assertions.Equal(7, post.Sys.Version)
+	assertions.Equal("Article", post.Name)
+	assertions.Same(post, plan.Operations[4].ContentType)
+	assertions.Equal([]string{"legacy"}, plan.Operations[4].DeletedFields)
+
+	// the diff is left unchanged
+	assertions.Len(stagingModel().ContentTypes[0].Fields, 3)
+
+	assertions.True(NewMigrationPlan(DiffContentModels(master, master)).Empty())
+	assertions.Equal("Nothing to do\n", NewMigrationPlan(DiffContentModels(master, master)).String())
+}
+
+func TestMigrationPlan_Apply(t *testing.T) {
+	assertions := assert.New(t)
+
+	server, requests := newMigrationServer(t, map[string]interface{}{
+		"GET content_types/post/editor_interface": map[string]interface{}{
+			"sys":      map[string]interface{}{"id": "default", "version": 5},
+			"controls": []interface{}{map[string]interface{}{"fieldId": "title", "widgetNamespace": "builtin", "widgetId": "singleLine"}},
+		},
+		"GET content_types/tag/editor_interface": map[string]interface{}{
+			"sys": map[string]interface{}{"id": "default", "version": 1},
+		},
+	})
+	defer server.Close()
+
+	cma = NewCMA(CMAToken, WithBaseURL(server.URL))
+
+	staging := stagingModel()
+	staging.EditorInterfaces = append(staging.EditorInterfaces, &EditorInterface{
+		Sys:      &Sys{ID: "default", ContentType: &ContentType{Sys: &Sys{ID: "tag"}}},
+		Controls: []Controls{{FieldID: "label", WidgetNameSpace: "builtin", WidgetID: "singleLine"}},
+	})
+
+	plan := NewMigrationPlan(DiffContentModels(masterModel(), staging))
+	err := cma.Space(spaceID).Environment("master").ApplyPlan(context.Background(), plan)
+	assertions.Nil(err)
+
+	received := requests()
+	assertions.Equal([]string{
+		"PUT content_types/post",
+		"PUT content_types/post/published",
+		"PUT content_types/tag",
+		"PUT content_types/tag/published",
+		"PUT content_types/post",
+		"PUT content_types/post/published",
+		"GET content_types/post/editor_interface",
+		"PUT content_types/post/editor_interface",
+		"GET content_types/tag/editor_interface",
+		"PUT content_types/tag/editor_interface",
+		"DELETE content_types/banner",
+	}, migrationKeys(received))
+
+	// the removed field is omitted, then deleted
+	assertions.Equal([]string{"title", "slug", "body", "legacy"}, fieldIDs(received[0].body))
+	legacy := received[0].body["fields"].([]interface{})[3].(map[string]interface{})
+	assertions.Equal(true, legacy["omitted"])
+	assertions.Nil(legacy["deleted"])
+
+	legacy = received[4].body["fields"].([]interface{})[3].(map[string]interface{})
+	assertions.Equal(true, legacy["deleted"])
+
+	controls := received[7].body["controls"].([]interface{})
+	assertions.Len(controls, 2)
+	assertions.Equal("slugEditor", controls[1].(map[string]interface{})["widgetId"])
+	assertions.Len(received[9].body["controls"], 1)
+}
@@ -0,0 +1,518 @@
+package contentful
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+)
+
+const (
+	// DefaultMigrationStateContentType is the content type, and the id of the
+	// entry, recording the applied migrations of an environment
+	DefaultMigrationStateContentType = "migrationState"
+
+	// DefaultMigrationLockTimeout is the age after which the lock of a run,
+	// which most likely crashed, is taken over
+	DefaultMigrationLockTimeout = 10 * time.Minute
+
+	// DefaultMigrationPollInterval is the interval between two attempts to take
+	// the lock, and between two polls of a cloned environment
+	DefaultMigrationPollInterval = 2 * time.Second
+)
+
+// ErrMigrationLockLost is returned by a run whose lock was taken over by
+// another run, which then holds the lock of the state
+var ErrMigrationLockLost = errors.New("contentful: migration lock was taken over by another run")
+
+// MigrationRunner applies named migrations to an environment once. The
+// applied migrations are recorded in an entry of a dedicated content type,
+// created on the first run, which also holds the lock preventing concurrent
+// runs.
+//
+//	runner := contentful.NewMigrationRunner(cma, spaceID, nil).
+//		Add("0001-create-post", createPost).
+//		Add("0002-add-slug", addSlug)
+//	report, err := runner.Run(ctx)
+type MigrationRunner struct {
+	client     *Client
+	spaceID    string
+	options    *MigrationRunnerOptions
+	migrations []*namedMigration
+}
+
+// MigrationRunnerOptions configures a MigrationRunner
+type MigrationRunnerOptions struct {
+	// StateContentType is the id of the content type recording the applied
+	// migrations, DefaultMigrationStateContentType when empty
+	StateContentType string
+
+	// Holder identifies the runner in the lock, the host name and the process
+	// id when empty
+	Holder string
+
+	// LockTimeout is the age of a lock after which it is taken over,
+	// DefaultMigrationLockTimeout when zero. A run refreshes its lock every
+	// third of it while a migration runs.
+	LockTimeout time.Duration
+
+	// PollInterval is the interval between two attempts to take the lock,
+	// DefaultMigrationPollInterval when zero
+	PollInterval time.Duration
+}
+
+// AppliedMigration is a migration recorded in the state of an environment
+type AppliedMigration struct {
+	Name      string    `json:"name"`
+	AppliedAt time.Time `json:"appliedAt"`
+}
+
+// MigrationRunReport lists the migrations applied by a run
+type MigrationRunReport struct {
+	// Environment the migrations were applied to
+	Environment string
+
+	// Applied lists the migrations applied by the run, in order
+	Applied []string
+
+	// Skipped lists the migrations which were already applied
+	Skipped []string
+
+	// Logs holds the log of each migration run, including the failing one
+	Logs map[string]*MigrationLog
+}
+
+type namedMigration struct {
+	name      string
+	migration *Migration
+}
+
+// migrationState is the entry recording the applied migrations
+type migrationState struct {
+	entry   *Entry
+	locale  string
+	applied []*AppliedMigration
+}
+
+// NewMigrationRunner returns a runner of migrations against the environment of the client
+func NewMigrationRunner(c *Client, spaceID string, options *MigrationRunnerOptions) *MigrationRunner {
+	opts := MigrationRunnerOptions{}
+	if options != nil {
+		opts = *options
+	}
+
+	if opts.StateContentType == "" {
+		opts.StateContentType = DefaultMigrationStateContentType
+	}
+
+	if opts.Holder == "" {
+		host, _ := os.Hostname()
+		opts.Holder = fmt.Sprintf("%s-%d", host, os.Getpid())
+	}
+
+	if opts.LockTimeout <= 0 {
+		opts.LockTimeout = DefaultMigrationLockTimeout
+	}
+
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = DefaultMigrationPollInterval
+	}
+
+	return &MigrationRunner{client: c, spaceID: spaceID, options: &opts}
+}
+
+// Add appends a migration, the name identifies it in the state of the environments
+func (runner *MigrationRunner) Add(name string, m *Migration) *MigrationRunner {
+	runner.migrations = append(runner.migrations, &namedMigration{name: name, migration: m})
+	return runner
+}
+
+// Applied returns the migrations applied to the environment, read without taking the lock
+func (runner *MigrationRunner) Applied(ctx context.Context) ([]*AppliedMigration, error) {
+	if _, err := runner.client.ContentTypes.Get(ctx, runner.spaceID, runner.options.StateContentType); err != nil {
+		var notFound NotFoundError
+		if errors.As(err, &notFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	state, err := runner.readState(ctx)
+	if err != nil || state == nil {
+		return nil, err
+	}
+
+	return state.applied, nil
+}
+
+// Run applies the migrations which were not applied to the environment yet,
+// in order, and stops at the first failing one. The state is locked during
+// the run, waiting for the lock of another run as long as ctx allows.
+func (runner *MigrationRunner) Run(ctx context.Context) (*MigrationRunReport, error) {
+	if err := runner.checkNames(); err != nil {
+		return nil, err
+	}
+
+	report := &MigrationRunReport{
+		Environment: runner.client.environment(ctx),
+		Logs:        map[string]*MigrationLog{},
+	}
+
+	if err := runner.ensureStateContentType(ctx); err != nil {
+		return report, err
+	}
+
+	state, err := runner.lock(ctx)
+	if err != nil {
+		return report, err
+	}
+
+	runErr := runner.apply(ctx, state, report)
+	if errors.Is(runErr, ErrMigrationLockLost) {
+		return report, runErr
+	}
+
+	// the lock is released even when the run is canceled
+	unlockCtx := WithEnvironment(context.Background(), report.Environment)
+	if err := runner.unlock(unlockCtx, state); err != nil {
+		runErr = errors.Join(runErr, err)
+	}
+
+	return report, runErr
+}
+
+// RollForward applies the pending migrations to a new environment, cloned
+// from the environment the alias points to, then points the alias to the new
+// environment. Nothing is cloned when no migration is pending, and the alias
+// is left unchanged when a migration fails.
+func (runner *MigrationRunner) RollForward(ctx context.Context, aliasID, environmentID string) (*MigrationRunReport, error) {
+	if err := runner.checkNames(); err != nil {
+		return nil, err
+	}
+
+	alias, err := runner.client.EnvironmentAliases.Get(ctx, runner.spaceID, aliasID)
+	if err != nil {
+		return nil, err
+	}
+
+	if alias.Alias == nil || alias.Alias.Sys == nil {
+		return nil, fmt.Errorf("contentful: environment alias %s has no environment", aliasID)
+	}
+
+	source := alias.Alias.Sys.ID
+	applied, err := runner.inEnvironment(source).Applied(WithEnvironment(ctx, source))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(runner.pending(applied)) == 0 {
+		report := &MigrationRunReport{Environment: source, Logs: map[string]*MigrationLog{}}
+		for _, m := range runner.migrations {
+			report.Skipped = append(report.Skipped, m.name)
+		}
+
+		return report, nil
+	}
+
+	environment := &Environment{Name: environmentID, SourceEnvironmentID: source}
+	if err := runner.client.Environments.Upsert(ctx, runner.spaceID, environment); err != nil {
+		return nil, err
+	}
+
+	if _, err := runner.client.Environments.WaitReady(ctx, runner.spaceID, environmentID, runner.options.PollInterval); err != nil {
+		return nil, err
+	}
+
+	report, err := runner.inEnvironment(environmentID).Run(WithEnvironment(ctx, environmentID))
+	if err != nil {
+		return report, err
+	}
+
+	alias.Alias.Sys.ID = environmentID
+	if err := runner.client.EnvironmentAliases.Update(ctx, runner.spaceID, alias); err != nil {
+		return report, err
+	}
+
+	return report, nil
+}
+
+// inEnvironment returns a runner of the same migrations against another
+// environment, the collections of which are bound to the environment of
+// their client whatever the context
+func (runner *MigrationRunner) inEnvironment(environmentID string) *MigrationRunner {
+	return &MigrationRunner{
+		client:     runner.client.withEnvironment(environmentID),
+		spaceID:    runner.spaceID,
+		options:    runner.options,
+		migrations: runner.migrations,
+	}
+}
+
+func (runner *MigrationRunner) checkNames() error {
+	names := map[string]bool{}
+	for _, m := range runner.migrations {
+		if m.name == "" || names[m.name] {
+			return fmt.Errorf("contentful: migration names must be unique and not empty, got %q", m.name)
+		}
+
+		names[m.name] = true
+	}
+
+	return nil
+}
+
+// pending returns the migrations which are not applied
+func (runner *MigrationRunner) pending(applied []*AppliedMigration) []*namedMigration {
+	done := map[string]bool{}
+	for _, m := range applied {
+		done[m.Name] = true
+	}
+
+	var pending []*namedMigration
+	for _, m := range runner.migrations {
+		if !done[m.name] {
+			pending = append(pending, m)
+		}
+	}
+
+	return pending
+}
+
+// apply runs the pending migrations and records each one once it succeeded.
+// It stops before the next migration when the lock was taken over.
+func (runner *MigrationRunner) apply(ctx context.Context, state *migrationState, report *MigrationRunReport) error {
+	pending := map[string]bool{}
+	for _, m := range runner.pending(state.applied) {
+		pending[m.name] = true
+	}
+
+	for _, m := range runner.migrations {
+		if !pending[m.name] {
+			report.Skipped = append(report.Skipped, m.name)
+			continue
+		}
+
+		var log *MigrationLog
+		err := runner.heartbeat(ctx, state, func() (err error) {
+			log, err = m.migration.Run(ctx, runner.client, runner.spaceID)
+			return err
+		})
+		report.Logs[m.name] = log
+		if err != nil {
+			return fmt.Errorf("contentful: migration %s: %w", m.name, err)
+		}
+
+		state.applied = append(state.applied, &AppliedMigration{Name: m.name, AppliedAt: time.Now().UTC()})
+		if err := runner.saveState(ctx, state, runner.options.Holder, time.Now()); err != nil {
+			var mismatch VersionMismatchError
+			if errors.As(err, &mismatch) {
+				return fmt.Errorf("contentful: migration %s: %w", m.name, ErrMigrationLockLost)
+			}
+
+			return err
+		}
+
+		report.Applied = append(report.Applied, m.name)
+	}
+
+	return nil
+}
+
+// heartbeat refreshes the lock of the state while run runs, so that a
+// migration outlasting the lock timeout keeps the lock. It returns
+// ErrMigrationLockLost when a refresh finds that another run took the lock.
+func (runner *MigrationRunner) heartbeat(ctx context.Context, state *migrationState, run func() error) error {
+	stop := make(chan struct{})
+	lost := make(chan bool, 1)
+	go func() {
+		ticker := time.NewTicker(runner.options.LockTimeout / 3)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-stop:
+				lost <- false
+				return
+			case <-ticker.C:
+				// a failed refresh is retried on the next tick, unless the
+				// version of the state shows another run took the lock
+				var mismatch VersionMismatchError
+				if err := runner.saveState(ctx, state, runner.options.Holder, time.Now()); errors.As(err, &mismatch) {
+					lost <- true
+					return
+				}
+			}
+		}
+	}()
+
+	err := run()
+	close(stop)
+	if <-lost {
+		return errors.Join(ErrMigrationLockLost, err)
+	}
+
+	return err
+}
+
+// ensureStateContentType creates and activates the state content type when
+// the environment has none
+func (runner *MigrationRunner) ensureStateContentType(ctx context.Context) error {
+	_, err := runner.client.ContentTypes.Get(ctx, runner.spaceID, runner.options.StateContentType)
+	var notFound NotFoundError
+	if !errors.As(err, &notFound) {
+		return err
+	}
+
+	contentType := &ContentType{
+		Sys:          &Sys{ID: runner.options.StateContentType},
+		Name:         "Migration state",
+		Description:  "Migrations applied to the environment",
+		DisplayField: "lockedBy",
+		Fields: []*Field{
+			{ID: "applied", Name: "Applied", Type: FieldTypeObject},
+			{ID: "lockedBy", Name: "Locked by", Type: FieldTypeSymbol},
+			{ID: "lockedAt", Name: "Locked at", Type: FieldTypeDate},
+		},
+	}
+
+	if err := runner.client.ContentTypes.Upsert(ctx, runner.spaceID, contentType); err != nil {
+		return err
+	}
+
+	return runner.client.ContentTypes.Activate(ctx, runner.spaceID, contentType)
+}
+
+// lock takes the lock of the state, retrying while another run holds it.
+// The version of the state entry makes taking the lock atomic.
+func (runner *MigrationRunner) lock(ctx context.Context) (*migrationState, error) {
+	holder := ""
+	for {
+		state, err := runner.tryLock(ctx, &holder)
+		if state != nil || err != nil {
+			if err != nil && ctx.Err() != nil && holder != "" {
+				err = fmt.Errorf("contentful: migration state is locked by %s: %w", holder, ctx.Err())
+			}
+
+			return state, err
+		}
+
+		if err := sleep(ctx, runner.options.PollInterval); err != nil {
+			return nil, fmt.Errorf("contentful: migration state is locked by %s: %w", holder, err)
+		}
+	}
+}
+
+// tryLock takes the lock of the state unless another run holds it, whose
+// holder is set. It returns no state and no error when the lock is held.
+func (runner *MigrationRunner) tryLock(ctx context.Context, holder *string) (*migrationState, error) {
+	state, err := runner.readState(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if state == nil {
+		if state, err = runner.newState(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	var lockedAt time.Time
+	*holder, lockedAt = state.lock()
+	if *holder != "" && *holder != runner.options.Holder && time.Since(lockedAt) <= runner.options.LockTimeout {
+		return nil, nil
+	}
+
+	err = runner.saveState(ctx, state, runner.options.Holder, time.Now())
+
+	// another run took the lock, or created the state, first
+	var mismatch VersionMismatchError
+	if errors.As(err, &mismatch) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return state, nil
+}
+
+// unlock releases the lock of the state
+func (runner *MigrationRunner) unlock(ctx context.Context, state *migrationState) error {
+	return runner.saveState(ctx, state, "", time.Time{})
+}
+
+// readState returns the state entry, nil when it does not exist
+func (runner *MigrationRunner) readState(ctx context.Context) (*migrationState, error) {
+	entry, err := findEntry(ctx, runner.client, runner.spaceID, runner.options.StateContentType)
+	if err != nil || entry == nil {
+		return nil, err
+	}
+
+	locale, err := runner.defaultLocale(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	state := &migrationState{entry: entry, locale: locale}
+	if applied, ok := localizedFields(entry, []string{"applied"}, locale)["applied"]; ok {
+		data, err := json.Marshal(applied)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(data, &state.applied); err != nil {
+			return nil, fmt.Errorf("contentful: invalid migration state: %w", err)
+		}
+	}
+
+	return state, nil
+}
+
+// newState returns a state entry to create
+func (runner *MigrationRunner) newState(ctx context.Context) (*migrationState, error) {
+	locale, err := runner.defaultLocale(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := &Entry{Sys: &Sys{ID: runner.options.StateContentType}, Fields: map[string]interface{}{}}
+
+	return &migrationState{entry: entry, locale: locale}, nil
+}
+
+// saveState saves the applied migrations and the lock of the state
+func (runner *MigrationRunner) saveState(ctx context.Context, state *migrationState, holder string, lockedAt time.Time) error {
+	setLocalizedField(state.entry, "applied", state.locale, state.applied)
+	if holder == "" {
+		delete(state.entry.Fields, "lockedBy")
+		delete(state.entry.Fields, "lockedAt")
+	} else {
+		setLocalizedField(state.entry, "lockedBy", state.locale, holder)
+		setLocalizedField(state.entry, "lockedAt", state.locale, lockedAt.UTC().Format(time.RFC3339))
+	}
+
+	return runner.client.Entries.Upsert(ctx, runner.spaceID, runner.options.StateContentType, state.entry)
+}
+
+// lock returns the holder of the lock of the state and when it was taken
+func (state *migrationState) lock() (string, time.Time) {
+	values := localizedFields(state.entry, []string{"lockedBy", "lockedAt"}, state.locale)
+
+	holder, _ := values["lockedBy"].(string)
+	lockedAt, _ := values["lockedAt"].(string)
+	at, _ := time.Parse(time.RFC3339, lockedAt)
+
+	return holder, at
+}
+
+// defaultLocale returns the code of the default locale of the environment
+func (runner *MigrationRunner) defaultLocale(ctx context.Context) (string, error) {
+	locales, err := All[*Locale](ctx, runner.client.Locales.List(runner.spaceID))
+	if err != nil {
+		return "", err
+	}
+
+	return defaultLocale(locales)[0].Code, nil
+}
@@ -0,0 +1,388 @@
+package contentful
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeEnvironments is a space whose environments store content types and
+// entries, checking their versions like the api does
+type fakeEnvironments struct {
+	mu           sync.Mutex
+	environments map[string]map[string]map[string]interface{}
+	statuses     map[string][]string
+	aliases      map[string]string
+	requests     []string
+}
+
+func newFakeEnvironments(environments ...string) *fakeEnvironments {
+	f := &fakeEnvironments{
+		environments: map[string]map[string]map[string]interface{}{},
+		statuses:     map[string][]string{},
+		aliases:      map[string]string{},
+	}
+
+	for _, environment := range environments {
+		f.environments[environment] = map[string]map[string]interface{}{}
+	}
+
+	return f
+}
+
+// state returns the fields of the migration state entry of an environment
+func (f *fakeEnvironments) state(environment string) map[string]interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	entry, ok := f.environments[environment]["entries/"+DefaultMigrationStateContentType]
+	if !ok {
+		return nil
+	}
+
+	fields := map[string]interface{}{}
+	for id, value := range entry["fields"].(map[string]interface{}) {
+		fields[id] = value.(map[string]interface{})["en-US"]
+	}
+
+	return fields
+}
+
+func (f *fakeEnvironments) put(environment, key string, entity map[string]interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.environments[environment][key] = entity
+}
+
+func (f *fakeEnvironments) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	path := strings.TrimPrefix(r.URL.Path, "/spaces/"+spaceID+"/")
+	f.requests = append(f.requests, r.Method+" "+path)
+
+	write := func(status int, response interface{}) {
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(response)
+	}
+
+	var body map[string]interface{}
+	_ = json.NewDecoder(r.Body).Decode(&body)
+
+	if strings.HasPrefix(path, "environment_aliases/") {
+		id := strings.TrimPrefix(path, "environment_aliases/")
+		if r.Method == "PUT" {
+			f.aliases[id] = body["environment"].(map[string]interface{})["sys"].(map[string]interface{})["id"].(string)
+		}
+
+		write(200, map[string]interface{}{
+			"sys":         map[string]interface{}{"id": id, "type": "EnvironmentAlias", "version": 1},
+			"environment": map[string]interface{}{"sys": map[string]interface{}{"id": f.aliases[id], "type": "Link", "linkType": "Environment"}},
+		})
+		return
+	}
+
+	parts := strings.SplitN(strings.TrimPrefix(path, "environments/"), "/", 2)
+	environment := parts[0]
+
+	// environments, created by cloning their source
+	if len(parts) == 1 {
+		if r.Method == "PUT" {
+			source := f.environments[r.Header.Get("X-Contentful-Source-Environment")]
+			clone := map[string]map[string]interface{}{}
+			for key, entity := range source {
+				clone[key] = entity
+			}
+
+			f.environments[environment] = clone
+			f.statuses[environment] = []string{EnvironmentStatusQueued, EnvironmentStatusReady}
+		}
+
+		status := EnvironmentStatusReady
+		if statuses := f.statuses[environment]; len(statuses) > 1 && r.Method == "GET" {
+			status, f.statuses[environment] = statuses[0], statuses[1:]
+		}
+
+		write(200, map[string]interface{}{
+			"name": environment,
+			"sys": map[string]interface{}{
+				"id":     environment,
+				"status": map[string]interface{}{"sys": map[string]interface{}{"type": "Link", "linkType": "Status", "id": status}},
+			},
+		})
+		return
+	}
+
+	store := f.environments[environment]
+	key := parts[1]
+
+	switch {
+	case key == "locales":
+		write(200, map[string]interface{}{"total": 1, "items": []interface{}{map[string]interface{}{"code": "en-US", "default": true}}})
+
+	case key == "entries" && r.Method == "GET":
+		var items []interface{}
+		if entry, ok := store["entries/"+r.URL.Query().Get("sys.id")]; ok {
+			items = append(items, entry)
+		}
+
+		write(200, map[string]interface{}{"total": len(items), "items": items})
+
+	case strings.HasSuffix(key, "/published"):
+		entity := store[strings.TrimSuffix(key, "/published")]
+		write(200, entity)
+
+	case r.Method == "GET":
+		entity, ok := store[key]
+		if !ok {
+			write(404, json.RawMessage(readTestData("error_notfound.json")))
+			return
+		}
+
+		write(200, entity)
+
+	case r.Method == "PUT":
+		// entries are created at version 0, content types at any version
+		current, exists := store[key]
+		version := 0
+		if exists {
+			version = int(current["sys"].(map[string]interface{})["version"].(float64))
+		}
+
+		if (exists || strings.HasPrefix(key, "entries/")) && r.Header.Get("X-Contentful-Version") != strconv.Itoa(version) {
+			write(409, json.RawMessage(readTestData("error_version_mismatch.json")))
+			return
+		}
+
+		body["sys"] = map[string]interface{}{"id": strings.Split(key, "/")[1], "version": float64(version + 1)}
+		store[key] = body
+		write(200, body)
+
+	default:
+		write(400, map[string]interface{}{})
+	}
+}
+
+func createTag(id string) *Migration {
+	m := NewMigration()
+	m.CreateContentType(id).Name(id).CreateField("label").Name("Label").Type(FieldTypeSymbol)
+
+	return m
+}
+
+func TestMigrationRunner_Run(t *testing.T) {
+	assertions := assert.New(t)
+
+	space := newFakeEnvironments("master")
+	server := httptest.NewServer(space)
+	defer server.Close()
+
+	cma = NewCMA(CMAToken, WithBaseURL(server.URL), WithRateLimiter(nil))
+
+	runner := NewMigrationRunner(cma, spaceID, &MigrationRunnerOptions{Holder: "ci"}).
+		Add("0001-tag", createTag("tag")).
+		Add("0002-category", createTag("category"))
+
+	report, err := runner.Run(context.Background())
+	assertions.Nil(err)
+	assertions.Equal("master", report.Environment)
+	assertions.Equal([]string{"0001-tag", "0002-category"}, report.Applied)
+	assertions.Empty(report.Skipped)
+	assertions.Len(report.Logs["0001-tag"].Steps, 1)
+
+	// the state is recorded and unlocked
+	state := space.state("master")
+	assertions.Nil(state["lockedBy"])
+	assertions.Len(state["applied"], 2)
+
+	// applied migrations are skipped
+	runner.Add("0003-topic", createTag("topic"))
+	report, err = runner.Run(context.Background())
+	assertions.Nil(err)
+	assertions.Equal([]string{"0003-topic"}, report.Applied)
+	assertions.Equal([]string{"0001-tag", "0002-category"}, report.Skipped)
+
+	applied, err := runner.Applied(context.Background())
+	assertions.Nil(err)
+	assertions.Len(applied, 3)
+	assertions.Equal("0003-topic", applied[2].Name)
+}
+
+func TestMigrationRunner_Failure(t *testing.T) {
+	assertions := assert.New(t)
+
+	space := newFakeEnvironments("master")
+	server := httptest.NewServer(space)
+	defer server.Close()
+
+	cma = NewCMA(CMAToken, WithBaseURL(server.URL), WithRateLimiter(nil))
+
+	missing := NewMigration()
+	missing.EditContentType("missing").Name("Missing")
+
+	report, err := NewMigrationRunner(cma, spaceID, nil).
+		Add("0001-tag", createTag("tag")).
+		Add("0002-missing", missing).
+		Add("0003-category", createTag("category")).
+		Run(context.Background())
+	assertions.NotNil(err)
+	assertions.Contains(err.Error(), "0002-missing")
+	assertions.Equal([]string{"0001-tag"}, report.Applied)
+	assertions.NotNil(report.Logs["0002-missing"].Steps[0].Err)
+
+	state := space.state("master")
+	assertions.Nil(state["lockedBy"])
+	assertions.Len(state["applied"], 1)
+
+	_, err = NewMigrationRunner(cma, spaceID, nil).Add("0001", NewMigration()).Add("0001", NewMigration()).Run(context.Background())
+	assertions.NotNil(err)
+}
+
+func TestMigrationRunner_Lock(t *testing.T) {
+	assertions := assert.New(t)
+
+	space := newFakeEnvironments("master")
+	server := httptest.NewServer(space)
+	defer server.Close()
+
+	cma = NewCMA(CMAToken, WithBaseURL(server.URL), WithRateLimiter(nil))
+
+	locked := func(at time.Time) {
+		space.put("master", "content_types/"+DefaultMigrationStateContentType, map[string]interface{}{"sys": map[string]interface{}{"id": DefaultMigrationStateContentType, "version": 2.0}})
+		space.put("master", "entries/"+DefaultMigrationStateContentType, map[string]interface{}{
+			"sys": map[string]interface{}{"id": DefaultMigrationStateContentType, "version": 5.0},
+			"fields": map[string]interface{}{
+				"lockedBy": map[string]interface{}{"en-US": "other"},
+				"lockedAt": map[string]interface{}{"en-US": at.UTC().Format(time.RFC3339)},
+			},
+		})
+	}
+
+	options := &MigrationRunnerOptions{Holder: "ci", PollInterval: 10 * time.Millisecond, LockTimeout: time.Hour}
+	runner := NewMigrationRunner(cma, spaceID, options).Add("0001-tag", createTag("tag"))
+
+	// a run waits for the lock of another run
+	locked(time.Now())
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := runner.Run(ctx)
+	assertions.NotNil(err)
+	assertions.Contains(err.Error(), "locked by other")
+	assertions.Equal("other", space.state("master")["lockedBy"])
+
+	// a stale lock is taken over
+	locked(time.Now().Add(-2 * time.Hour))
+	report, err := runner.Run(context.Background())
+	assertions.Nil(err)
+	assertions.Equal([]string{"0001-tag"}, report.Applied)
+	assertions.Nil(space.state("master")["lockedBy"])
+}
+
+func TestMigrationRunner_Heartbeat(t *testing.T) {
+	assertions := assert.New(t)
+
+	space := newFakeEnvironments("master")
+
+	// the migrations of the slow content types outlast the lock timeout
+	var takeOver func()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" && strings.Contains(r.URL.Path, "/content_types/slow") {
+			time.Sleep(150 * time.Millisecond)
+			if takeOver != nil {
+				takeOver()
+			}
+		}
+
+		space.ServeHTTP(w, r)
+	}))
+	defer server.Close()
+
+	cma = NewCMA(CMAToken, WithBaseURL(server.URL), WithRateLimiter(nil))
+
+	refreshes := func() int {
+		space.mu.Lock()
+		defer space.mu.Unlock()
+
+		count := 0
+		for _, request := range space.requests {
+			if request == "PUT environments/master/entries/"+DefaultMigrationStateContentType {
+				count++
+			}
+		}
+
+		return count
+	}
+
+	options := &MigrationRunnerOptions{Holder: "ci", PollInterval: 10 * time.Millisecond, LockTimeout: 30 * time.Millisecond}
+
+	// the lock is refreshed while the migration runs
+	report, err := NewMigrationRunner(cma, spaceID, options).Add("0001-slow", createTag("slow")).Run(context.Background())
+	assertions.Nil(err)
+	assertions.Equal([]string{"0001-slow"}, report.Applied)
+	assertions.Greater(refreshes(), 3)
+	assertions.Nil(space.state("master")["lockedBy"])
+
+	// the run stops once another run took the lock
+	takeOver = func() {
+		space.put("master", "entries/"+DefaultMigrationStateContentType, map[string]interface{}{
+			"sys": map[string]interface{}{"id": DefaultMigrationStateContentType, "version": 99.0},
+			"fields": map[string]interface{}{
+				"lockedBy": map[string]interface{}{"en-US": "other"},
+				"lockedAt": map[string]interface{}{"en-US": time.Now().UTC().Format(time.RFC3339)},
+			},
+		})
+	}
+
+	report, err = NewMigrationRunner(cma, spaceID, options).
+		Add("0001-slow", createTag("slow")).
+		Add("0002-slower", createTag("slower")).
+		Add("0003-tag", createTag("tag")).
+		Run(context.Background())
+	assertions.ErrorIs(err, ErrMigrationLockLost)
+	assertions.Contains(err.Error(), "0002-slower")
+	assertions.Empty(report.Applied)
+	assertions.NotContains(report.Logs, "0003-tag")
+	assertions.Equal("other", space.state("master")["lockedBy"])
+}
+
+func TestMigrationRunner_RollForward(t *testing.T) {
+	assertions := assert.New(t)
+
+	space := newFakeEnvironments("release-1")
+	space.aliases["master"] = "release-1"
+	server := httptest.NewServer(space)
+	defer server.Close()
+
+	cma = NewCMA(CMAToken, WithBaseURL(server.URL), WithRateLimiter(nil))
+
+	options := &MigrationRunnerOptions{PollInterval: time.Millisecond}
+	runner := NewMigrationRunner(cma, spaceID, options).Add("0001-tag", createTag("tag"))
+
+	report, err := cma.Space(spaceID).Environment("master").MigrationRunner(options).Add("0001-tag", createTag("tag")).RollForward(context.Background(), "master", "release-2")
+	assertions.Nil(err)
+	assertions.Equal("release-2", report.Environment)
+	assertions.Equal([]string{"0001-tag"}, report.Applied)
+	assertions.Equal("release-2", space.aliases["master"])
+
+	// the source environment is left unchanged
+	assertions.Nil(space.state("release-1"))
+	assertions.Len(space.state("release-2")["applied"], 1)
+	assertions.Contains(space.requests, "PUT environments/release-2")
+
+	// nothing is cloned without pending migrations
+	report, err = runner.RollForward(context.Background(), "master", "release-3")
+	assertions.Nil(err)
+	assertions.Equal("release-2", report.Environment)
+	assertions.Equal([]string{"0001-tag"}, report.Skipped)
+	assertions.NotContains(space.requests, "PUT environments/release-3")
+}
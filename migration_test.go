@@ -0,0 +1,317 @@
+package contentful
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// migrationRequest is a request received by the migration server
+type migrationRequest struct {
+	key  string
+	body map[string]interface{}
+}
+
+// newMigrationServer serves the responses keyed by method and path relative
+// to the environment like newTargetServer, a nil response is a 404. The
+// requests and their bodies are recorded.
+func newMigrationServer(t *testing.T, responses map[string]interface{}) (*httptest.Server, func() []migrationRequest) {
+	var mu sync.Mutex
+	var requests []migrationRequest
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		checkHeaders(r, assert.New(t))
+
+		key := r.Method + " " + strings.TrimPrefix(r.URL.Path, "/spaces/"+spaceID+"/environments/master/")
+		body := map[string]interface{}{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+
+		mu.Lock()
+		requests = append(requests, migrationRequest{key: key, body: body})
+		mu.Unlock()
+
+		response, ok := responses[key]
+		switch {
+		case ok && response == nil:
+			w.WriteHeader(404)
+			_, _ = w.Write([]byte(readTestData("error_notfound.json")))
+			return
+		case !ok:
+			body["sys"] = map[string]interface{}{"id": strings.Split(key, "/")[1], "version": 2}
+			response = body
+		}
+
+		if items, ok := response.([]interface{}); ok {
+			if r.URL.Query().Get("skip") != "" && r.URL.Query().Get("skip") != "0" || r.URL.Query().Get("pageNext") != "" {
+				items = nil
+			}
+
+			// the filters by id and content type
+			id, contentType := r.URL.Query().Get("sys.id"), r.URL.Query().Get("content_type")
+			var found []interface{}
+			for _, item := range items {
+				sys, _ := item.(map[string]interface{})["sys"].(map[string]interface{})
+				link, _ := sys["contentType"].(map[string]interface{})
+				switch {
+				case id != "" && sys["id"] != id:
+				case contentType != "" && (link == nil || link["sys"].(map[string]interface{})["id"] != contentType):
+				default:
+					found = append(found, item)
+				}
+			}
+			items = found
+
+			response = map[string]interface{}{"sys": map[string]interface{}{"type": "Array"}, "total": len(items), "items": items}
+		}
+
+		w.WriteHeader(200)
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+
+	return server, func() []migrationRequest {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return append([]migrationRequest(nil), requests...)
+	}
+}
+
+func migrationKeys(requests []migrationRequest) []string {
+	keys := make([]string, len(requests))
+	for i, request := range requests {
+		keys[i] = request.key
+	}
+
+	return keys
+}
+
+func fieldIDs(body map[string]interface{}) []string {
+	var ids []string
+	for _, field := range body["fields"].([]interface{}) {
+		ids = append(ids, field.(map[string]interface{})["id"].(string))
+	}
+
+	return ids
+}
+
+func TestMigration_EditContentType(t *testing.T) {
+	assertions := assert.New(t)
+
+	server, requests := newMigrationServer(t, map[string]interface{}{
+		"GET content_types/post": map[string]interface{}{
+			"sys":          map[string]interface{}{"id": "post", "version": 3},
+			"name":         "Post",
+			"displayField": "body",
+			"fields": []interface{}{
+				map[string]interface{}{"id": "title", "name": "Title", "type": "Symbol"},
+				map[string]interface{}{"id": "body", "name": "Body", "type": "Text"},
+				map[string]interface{}{"id": "legacy", "name": "Legacy", "type": "Symbol"},
+			},
+		},
+		"GET content_types/post/editor_interface": map[string]interface{}{
+			"sys":      map[string]interface{}{"id": "default", "version": 5},
+			"controls": []interface{}{map[string]interface{}{"fieldId": "title", "widgetNamespace": "builtin", "widgetId": "singleLine"}},
+		},
+	})
+	defer server.Close()
+
+	cma = NewCMA(CMAToken, WithBaseURL(server.URL))
+
+	m := NewMigration()
+	post := m.EditContentType("post").Name("Article")
+	post.CreateField("slug").Name("Slug").Type(FieldTypeSymbol).Required(true)
+	post.MoveField("slug").AfterField("title")
+	post.ChangeFieldID("body", "content")
+	post.DeleteField("legacy")
+	post.ChangeFieldControl("slug", "builtin", "slugEditor", nil)
+
+	log, err := cma.Space(spaceID).Environment("master").Migrate(context.Background(), m)
+	assertions.Nil(err)
+	assertions.Len(log.Steps, 1)
+	assertions.Equal([]string{"Edit content type post"}, m.Describe())
+	assertions.Contains(log.String(), "  - Move field slug after title\n")
+
+	received := requests()
+	assertions.Equal([]string{
+		"GET content_types/post",
+		"PUT content_types/post",
+		"PUT content_types/post/published",
+		"PUT content_types/post",
+		"PUT content_types/post/published",
+		"GET content_types/post/editor_interface",
+		"PUT content_types/post/editor_interface",
+	}, migrationKeys(received))
+
+	// the field is omitted first, then deleted
+	omitted := received[1].body
+	assertions.Equal("Article", omitted["name"])
+	assertions.Equal("content", omitted["displayField"])
+	assertions.Equal([]string{"title", "slug", "body", "legacy"}, fieldIDs(omitted))
+	assertions.Equal("content", omitted["fields"].([]interface{})[2].(map[string]interface{})["newId"])
+	assertions.Equal(true, omitted["fields"].([]interface{})[3].(map[string]interface{})["omitted"])
+	assertions.Nil(omitted["fields"].([]interface{})[3].(map[string]interface{})["deleted"])
+	assertions.Equal(true, received[3].body["fields"].([]interface{})[3].(map[string]interface{})["deleted"])
+
+	controls := received[6].body["controls"].([]interface{})
+	assertions.Len(controls, 2)
+	assertions.Equal("slugEditor", controls[1].(map[string]interface{})["widgetId"])
+}
+
+func TestMigration_CreateContentType(t *testing.T) {
+	assertions := assert.New(t)
+
+	server, requests := newMigrationServer(t, map[string]interface{}{
+		"GET content_types/tag":  nil,
+		"GET content_types/post": map[string]interface{}{"sys": map[string]interface{}{"id": "post", "version": 1}},
+	})
+	defer server.Close()
+
+	cma = NewCMA(CMAToken, WithBaseURL(server.URL))
+
+	m := NewMigration()
+	tag := m.CreateContentType("tag").Name("Tag").DisplayField("label")
+	tag.CreateField("label").Name("Label").Type(FieldTypeSymbol)
+	m.CreateContentType("post").Name("Post")
+	m.DeleteContentType("tag")
+
+	log, err := m.Run(context.Background(), cma, spaceID)
+	assertions.NotNil(err)
+	assertions.Contains(err.Error(), "content type post already exists")
+
+	// the run stops at the failing step
+	assertions.Len(log.Steps, 2)
+	assertions.Nil(log.Steps[0].Err)
+	assertions.NotNil(log.Steps[1].Err)
+	assertions.Equal([]string{
+		"GET content_types/tag",
+		"PUT content_types/tag",
+		"PUT content_types/tag/published",
+		"GET content_types/post",
+	}, migrationKeys(requests()))
+	assertions.Equal("Tag", requests()[1].body["name"])
+	assertions.Equal([]string{"label"}, fieldIDs(requests()[1].body))
+}
+
+func TestMigration_TransformEntries(t *testing.T) {
+	assertions := assert.New(t)
+
+	server, requests := newMigrationServer(t, map[string]interface{}{
+		"GET locales": []interface{}{
+			map[string]interface{}{"code": "en-US", "default": true},
+			map[string]interface{}{"code": "de-DE"},
+		},
+		"GET entries": []interface{}{
+			map[string]interface{}{
+				"sys":    map[string]interface{}{"id": "post-1", "version": 4, "publishedVersion": 3, "contentType": map[string]interface{}{"sys": map[string]interface{}{"id": "post"}}},
+				"fields": map[string]interface{}{"title": map[string]interface{}{"en-US": "hello", "de-DE": "hallo"}},
+			},
+			map[string]interface{}{
+				"sys":    map[string]interface{}{"id": "post-2", "version": 1, "contentType": map[string]interface{}{"sys": map[string]interface{}{"id": "post"}}},
+				"fields": map[string]interface{}{"title": map[string]interface{}{"en-US": "draft"}},
+			},
+		},
+	})
+	defer server.Close()
+
+	cma = NewCMA(CMAToken, WithBaseURL(server.URL))
+
+	m := NewMigration()
+	m.TransformEntries(&EntryTransform{
+		ContentType: "post",
+		From:        []string{"title"},
+		To:          []string{"slug"},
+		Transform: func(from map[string]interface{}, locale string) (map[string]interface{}, error) {
+			title, ok := from["title"].(string)
+			if !ok {
+				return nil, nil
+			}
+
+			return map[string]interface{}{"slug": strings.ToUpper(title)}, nil
+		},
+		ShouldPublish: true,
+	})
+
+	log, err := m.Run(context.Background(), cma, spaceID)
+	assertions.Nil(err)
+	assertions.Equal([]string{"Update 2 entries"}, log.Steps[0].Details)
+
+	received := requests()
+	assertions.Equal([]string{
+		"GET locales",
+		"GET entries",
+		"PUT entries/post-1",
+		"PUT entries/post-1/published",
+		"PUT entries/post-2",
+	}, migrationKeys(received)[:5])
+	assertions.Equal(map[string]interface{}{"en-US": "HELLO", "de-DE": "HALLO"}, received[2].body["fields"].(map[string]interface{})["slug"])
+	assertions.Equal(map[string]interface{}{"en-US": "DRAFT"}, received[4].body["fields"].(map[string]interface{})["slug"])
+}
+
+func TestMigration_DeriveLinkedEntries(t *testing.T) {
+	assertions := assert.New(t)
+
+	author := func(id, name string) map[string]interface{} {
+		return map[string]interface{}{
+			"sys":    map[string]interface{}{"id": id, "version": 1, "contentType": map[string]interface{}{"sys": map[string]interface{}{"id": "post"}}},
+			"fields": map[string]interface{}{"authorName": map[string]interface{}{"en-US": name}},
+		}
+	}
+
+	server, requests := newMigrationServer(t, map[string]interface{}{
+		"GET locales": []interface{}{map[string]interface{}{"code": "en-US", "default": true}},
+		"GET content_types/post": map[string]interface{}{
+			"sys":    map[string]interface{}{"id": "post", "version": 1},
+			"fields": []interface{}{map[string]interface{}{"id": "author", "type": "Link", "linkType": "Entry"}},
+		},
+		"GET entries": []interface{}{
+			author("post-1", "Jane"), author("post-2", "Jane"), author("post-3", "John"),
+			map[string]interface{}{"sys": map[string]interface{}{"id": "author-john", "version": 1}},
+		},
+	})
+	defer server.Close()
+
+	cma = NewCMA(CMAToken, WithBaseURL(server.URL))
+
+	m := NewMigration()
+	m.DeriveLinkedEntries(&EntryDerivation{
+		ContentType:        "post",
+		From:               []string{"authorName"},
+		ToReferenceField:   "author",
+		DerivedContentType: "author",
+		DerivedFields:      []string{"name"},
+		IdentityKey: func(from map[string]interface{}) (string, error) {
+			return "author-" + strings.ToLower(from["authorName"].(string)), nil
+		},
+		Derive: func(from map[string]interface{}, locale string) (map[string]interface{}, error) {
+			return map[string]interface{}{"name": from["authorName"]}, nil
+		},
+	})
+
+	log, err := m.Run(context.Background(), cma, spaceID)
+	assertions.Nil(err)
+	assertions.Equal([]string{"Create 1 entries, link 3 entries"}, log.Steps[0].Details)
+
+	var writes []migrationRequest
+	for _, request := range requests() {
+		if strings.HasPrefix(request.key, "PUT ") {
+			writes = append(writes, request)
+		}
+	}
+
+	assertions.Equal([]string{
+		"PUT entries/author-jane",
+		"PUT entries/post-1",
+		"PUT entries/post-2",
+		"PUT entries/post-3",
+	}, migrationKeys(writes))
+	assertions.Equal(map[string]interface{}{"en-US": "Jane"}, writes[0].body["fields"].(map[string]interface{})["name"])
+
+	link := writes[3].body["fields"].(map[string]interface{})["author"].(map[string]interface{})["en-US"]
+	assertions.Equal("author-john", link.(map[string]interface{})["sys"].(map[string]interface{})["id"])
+}
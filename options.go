@@ -0,0 +1,104 @@
+package contentful
+
+import (
+	"net/http"
+)
+
+// Option configures a client at construction, see NewCMA, NewCDA, NewCPA and
+// NewResourceClient
+type Option func(c *Client)
+
+// WithBaseURL sets the url of the api, e.g. to use a proxy or a test server
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) {
+		c.baseURL = baseURL
+	}
+}
+
+// WithHTTPClient sets the http.Client used to make requests
+func WithHTTPClient(client *http.Client) Option {
+	return func(c *Client) {
+		c.client = client
+	}
+}
+
+// WithUserAgent sets the User-Agent header of the requests
+func WithUserAgent(userAgent string) Option {
+	return WithHeader("User-Agent", userAgent)
+}
+
+// WithOrganization sets the organization of the requests
+func WithOrganization(organizationID string) Option {
+	return WithHeader("X-Contentful-Organization", organizationID)
+}
+
+// WithHeader sets a header sent with every request
+func WithHeader(key, value string) Option {
+	return func(c *Client) {
+		c.headers = withHeader(c.headers, key, value)
+	}
+}
+
+// WithQueryParam sets a query param sent with every request
+func WithQueryParam(key, value string) Option {
+	return func(c *Client) {
+		c.queryParams = withHeader(c.queryParams, key, value)
+	}
+}
+
+// WithDefaultEnvironment sets the environment of the client, which defaults to master
+func WithDefaultEnvironment(environment string) Option {
+	return func(c *Client) {
+		c.environmentID = environment
+	}
+}
+
+// WithRetryPolicy sets the policy used to retry failed requests
+func WithRetryPolicy(policy *RetryPolicy) Option {
+	return func(c *Client) {
+		c.retryPolicy = policy
+	}
+}
+
+// WithRateLimiter sets the limiter used to stay under the api quotas, e.g.
+// to share one limiter between clients using the same access token
+func WithRateLimiter(limiter *RateLimiter) Option {
+	return func(c *Client) {
+		c.rateLimiter = limiter
+	}
+}
+
+// WithDebug prints the requests as curl commands, and the error responses
+func WithDebug(debug bool) Option {
+	return func(c *Client) {
+		c.debug = debug
+	}
+}
+
+// apply configures the client with the given options
+func (c *Client) apply(options []Option) *Client {
+	for _, option := range options {
+		option(c)
+	}
+
+	return c
+}
+
+// copyHeaders returns a copy of a map of headers or query params
+func copyHeaders(values map[string]string) map[string]string {
+	c := make(map[string]string, len(values))
+	for k, v := range values {
+		c[k] = v
+	}
+
+	return c
+}
+
+// withHeader returns a copy of a map of headers or query params with the
+// given value. Maps shared with requests in flight are never modified.
+func withHeader(values map[string]string, key, value string) map[string]string {
+	c := copyHeaders(values)
+	c[key] = value
+
+	return c
+}
@@ -0,0 +1,107 @@
+package contentful
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientOptions(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal("/spaces/id1/environments/staging/entries/5KsDBWseXY6QegucYAoacS", r.URL.Path)
+		assertions.Equal("my-app/1.0", r.Header.Get("User-Agent"))
+		assertions.Equal(organizationID, r.Header.Get("X-Contentful-Organization"))
+		assertions.Equal("value", r.Header.Get("X-Custom"))
+		assertions.Equal("*", r.URL.Query().Get("locale"))
+
+		checkHeaders(r, assertions)
+
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("entry_1.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	httpClient := &http.Client{}
+	limiter := NewRateLimiter(1)
+	policy := NoRetryPolicy()
+
+	cma := NewCMA(CMAToken,
+		WithBaseURL(server.URL),
+		WithHTTPClient(httpClient),
+		WithUserAgent("my-app/1.0"),
+		WithOrganization(organizationID),
+		WithHeader("X-Custom", "value"),
+		WithQueryParam("locale", "*"),
+		WithDefaultEnvironment("staging"),
+		WithRetryPolicy(policy),
+		WithRateLimiter(limiter),
+	)
+
+	assertions.Same(httpClient, cma.client)
+	assertions.Same(limiter, cma.RateLimiter())
+	assertions.Same(policy, cma.RetryPolicy())
+
+	_, err := cma.Entries.Get(context.Background(), spaceID, "5KsDBWseXY6QegucYAoacS")
+	assertions.Nil(err)
+}
+
+func TestClientConcurrentUse(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("entry_1.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	cma := NewCMA(CMAToken, WithBaseURL(server.URL), WithRateLimiter(nil))
+
+	// reconfiguring the client while requests are in flight is not a data race
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+
+		go func() {
+			defer wg.Done()
+
+			_, err := cma.Entries.Get(context.Background(), spaceID, "5KsDBWseXY6QegucYAoacS")
+			assertions.Nil(err)
+
+			_, err = cma.Entries.List(spaceID).Next(context.Background())
+			assertions.Nil(err)
+		}()
+
+		go func(i int) {
+			defer wg.Done()
+
+			cma.SetOrganization(fmt.Sprintf("org-%d", i))
+			cma.SetEnvironment("master")
+			cma.SetRetryPolicy(NoRetryPolicy())
+		}(i)
+	}
+
+	wg.Wait()
+}
+
+func TestNewRequestKeepsQuery(t *testing.T) {
+	assertions := assert.New(t)
+
+	cma := NewCMA(CMAToken, WithQueryParam("locale", "*"))
+
+	req, err := cma.newRequest(context.Background(), http.MethodGet, "/spaces", nil, nil)
+	assertions.Nil(err)
+	assertions.Equal("locale=%2A", req.URL.RawQuery)
+}
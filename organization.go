@@ -1,6 +1,7 @@
 package contentful
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -18,7 +19,7 @@ func (service *OrganizationsService) List() *Collection {
 	path := fmt.Sprintf("/organizations")
 	method := "GET"
 
-	req, err := service.c.newRequest(method, path, nil, nil)
+	req, err := service.c.newRequest(context.Background(), method, path, nil, nil)
 	if err != nil {
 		return nil
 	}
@@ -1,6 +1,7 @@
 package contentful
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -29,9 +30,9 @@ func TestOrganizationsService_List(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	collection, err := cma.Organizations.List().Next()
+	collection, err := cma.Organizations.List().Next(context.Background())
 	assertions.Nil(err)
 	organization := collection.ToOrganization()
 	assertions.Equal(1, len(organization))
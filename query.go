@@ -30,7 +30,7 @@ type Query struct {
 	within      map[string]string
 	order       []string
 	limit       uint16
-	skip        uint16
+	skip        int
 	mime        string
 	locale      string
 }
@@ -197,7 +197,13 @@ func (q *Query) Limit(limit uint16) *Query {
 
 // Skip query
 func (q *Query) Skip(skip uint16) *Query {
-	q.skip = skip
+	q.skip = int(skip)
+	return q
+}
+
+// Offset sets the skip query, like Skip, for offsets past the uint16 range
+func (q *Query) Offset(offset int) *Query {
+	q.skip = offset
 	return q
 }
 
@@ -390,7 +396,7 @@ func (q *Query) Values() url.Values {
 	}
 
 	if q.skip != 0 {
-		params.Set("skip", strconv.Itoa(int(q.skip)))
+		params.Set("skip", strconv.Itoa(q.skip))
 	}
 
 	if q.mime != "" {
@@ -263,6 +263,13 @@ func TestQuerySkip(t *testing.T) {
 	assert.Equal(t, expected.Encode(), q.String())
 }
 
+func TestQueryOffset(t *testing.T) {
+	q := NewQuery().Offset(100000)
+	expected := url.Values{}
+	expected.Set("skip", "100000")
+	assert.Equal(t, expected.Encode(), q.String())
+}
+
 func TestQueryMimeType(t *testing.T) {
 	q := NewQuery().MimeType("image")
 	expected := url.Values{}
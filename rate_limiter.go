@@ -0,0 +1,166 @@
+package contentful
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// noinspection GoUnusedConst
+const (
+	// DefaultCMARateLimit default number of requests per second of the management api
+	DefaultCMARateLimit = 7
+
+	// DefaultCDARateLimit default number of requests per second of the delivery api
+	DefaultCDARateLimit = 55
+
+	// DefaultCPARateLimit default number of requests per second of the preview api
+	DefaultCPARateLimit = 14
+)
+
+// RateLimiter is a token bucket which keeps the requests of a client under
+// the per second and hourly quotas of Contentful. It starts with the given
+// rate and adapts itself to the X-Contentful-RateLimit-* headers of every
+// response. Once the hourly quota is exhausted, requests wait until the reset
+// reported by the api, or for an hour when it is not reported. A RateLimiter
+// is safe for concurrent use and can be shared between clients using the same
+// access token.
+type RateLimiter struct {
+	mu            sync.Mutex
+	perSecond     float64
+	tokens        float64
+	last          time.Time
+	hourLimit     int
+	hourRemaining int
+	blockedUntil  time.Time
+}
+
+// NewRateLimiter returns a rate limiter allowing perSecond requests per second
+// until the quota is reported by the api
+func NewRateLimiter(perSecond float64) *RateLimiter {
+	return &RateLimiter{
+		perSecond:     perSecond,
+		tokens:        perSecond,
+		last:          time.Now(),
+		hourLimit:     -1,
+		hourRemaining: -1,
+	}
+}
+
+// Wait blocks until a request may be sent, or until the context is done
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	for {
+		l.mu.Lock()
+		d := l.reserve(time.Now())
+		l.mu.Unlock()
+
+		if d <= 0 {
+			return nil
+		}
+
+		if err := sleep(ctx, d); err != nil {
+			return err
+		}
+	}
+}
+
+// reserve takes a token and returns zero, or returns how long to wait before
+// trying again
+func (l *RateLimiter) reserve(now time.Time) time.Duration {
+	if now.Before(l.blockedUntil) {
+		return l.blockedUntil.Sub(now)
+	}
+
+	if l.perSecond <= 0 {
+		return 0
+	}
+
+	l.refill(now)
+
+	if l.tokens >= 1 {
+		l.tokens--
+		return 0
+	}
+
+	return time.Duration((1 - l.tokens) / l.perSecond * float64(time.Second))
+}
+
+func (l *RateLimiter) refill(now time.Time) {
+	elapsed := now.Sub(l.last).Seconds()
+	if elapsed <= 0 {
+		return
+	}
+
+	l.tokens = math.Min(l.perSecond, l.tokens+elapsed*l.perSecond)
+	l.last = now
+}
+
+// Update adjusts the limiter to the rate limit headers of a response
+func (l *RateLimiter) Update(header http.Header) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.refill(now)
+
+	if limit, ok := headerInt(header, "X-Contentful-RateLimit-Second-Limit"); ok && limit > 0 {
+		l.perSecond = float64(limit)
+	}
+
+	if remaining, ok := headerInt(header, "X-Contentful-RateLimit-Second-Remaining"); ok {
+		l.tokens = math.Min(l.tokens, float64(remaining))
+	}
+
+	if limit, ok := headerInt(header, "X-Contentful-RateLimit-Hour-Limit"); ok {
+		l.hourLimit = limit
+	}
+
+	var until time.Time
+
+	// the hourly quota is available again at the latest an hour after it is
+	// exhausted, the per second refill must not let requests through before
+	if remaining, ok := headerInt(header, "X-Contentful-RateLimit-Hour-Remaining"); ok {
+		l.hourRemaining = remaining
+		l.tokens = math.Min(l.tokens, float64(remaining))
+
+		if remaining <= 0 {
+			until = now.Add(time.Hour)
+		}
+	}
+
+	// the reset header is sent along with 429 responses, every request has to
+	// wait until the quota is available again
+	if reset, ok := headerInt(header, "X-Contentful-RateLimit-Reset"); ok && reset > 0 {
+		until = now.Add(time.Duration(reset) * time.Second)
+	}
+
+	if until.After(l.blockedUntil) {
+		l.blockedUntil = until
+	}
+}
+
+// HourlyQuota returns the hourly limit and the remaining requests as last
+// reported by the api, or -1 when they are unknown
+func (l *RateLimiter) HourlyQuota() (limit, remaining int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.hourLimit, l.hourRemaining
+}
+
+func headerInt(header http.Header, key string) (int, bool) {
+	value := header.Get(key)
+	if value == "" {
+		return 0, false
+	}
+
+	i, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, false
+	}
+
+	return i, true
+}
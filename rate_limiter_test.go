@@ -0,0 +1,140 @@
+package contentful
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimiterBurst(t *testing.T) {
+	assertions := assert.New(t)
+
+	limiter := NewRateLimiter(2)
+	now := time.Now()
+
+	assertions.Equal(time.Duration(0), limiter.reserve(now))
+	assertions.Equal(time.Duration(0), limiter.reserve(now))
+	assertions.Equal(500*time.Millisecond, limiter.reserve(now))
+
+	// half a second later one token is refilled
+	assertions.Equal(time.Duration(0), limiter.reserve(now.Add(500*time.Millisecond)))
+}
+
+func TestRateLimiterUpdate(t *testing.T) {
+	assertions := assert.New(t)
+
+	limiter := NewRateLimiter(DefaultCMARateLimit)
+
+	header := http.Header{}
+	header.Set("X-Contentful-RateLimit-Second-Limit", "10")
+	header.Set("X-Contentful-RateLimit-Second-Remaining", "0")
+	header.Set("X-Contentful-RateLimit-Hour-Limit", "36000")
+	header.Set("X-Contentful-RateLimit-Hour-Remaining", "35883")
+	limiter.Update(header)
+
+	assertions.Equal(float64(10), limiter.perSecond)
+	assertions.Greater(limiter.reserve(limiter.last), time.Duration(0))
+
+	limit, remaining := limiter.HourlyQuota()
+	assertions.Equal(36000, limit)
+	assertions.Equal(35883, remaining)
+
+	header = http.Header{}
+	header.Set("X-Contentful-RateLimit-Reset", "3")
+	limiter.Update(header)
+
+	assertions.InDelta(3*time.Second, limiter.reserve(time.Now()), float64(100*time.Millisecond))
+}
+
+func TestRateLimiterHourlyQuotaExhausted(t *testing.T) {
+	assertions := assert.New(t)
+
+	limiter := NewRateLimiter(DefaultCMARateLimit)
+
+	header := http.Header{}
+	header.Set("X-Contentful-RateLimit-Second-Remaining", "5")
+	header.Set("X-Contentful-RateLimit-Hour-Remaining", "0")
+	limiter.Update(header)
+
+	// the per second refill does not let requests through before the hour
+	now := time.Now()
+	assertions.InDelta(time.Hour, limiter.reserve(now), float64(time.Second))
+	assertions.InDelta(time.Hour-time.Minute, limiter.reserve(now.Add(time.Minute)), float64(time.Second))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	assertions.ErrorIs(limiter.Wait(ctx), context.DeadlineExceeded)
+
+	// the reset reported by the api is used when it is known
+	header.Set("X-Contentful-RateLimit-Reset", "3")
+	limiter = NewRateLimiter(DefaultCMARateLimit)
+	limiter.Update(header)
+
+	assertions.InDelta(3*time.Second, limiter.reserve(time.Now()), float64(100*time.Millisecond))
+}
+
+func TestRateLimiterWaitCanceled(t *testing.T) {
+	assertions := assert.New(t)
+
+	limiter := NewRateLimiter(1)
+	header := http.Header{}
+	header.Set("X-Contentful-RateLimit-Reset", "60")
+	limiter.Update(header)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	assertions.ErrorIs(limiter.Wait(ctx), context.DeadlineExceeded)
+}
+
+func TestRateLimiterSharedByWorkers(t *testing.T) {
+	assertions := assert.New(t)
+
+	var mu sync.Mutex
+	var requests []time.Time
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests = append(requests, time.Now())
+		mu.Unlock()
+
+		w.Header().Set("X-Contentful-RateLimit-Second-Limit", "20")
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("space-1.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.baseURL = server.URL
+	cma.SetRateLimiter(NewRateLimiter(20))
+
+	start := time.Now()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 5; j++ {
+				_, err := cma.Spaces.Get(context.Background(), spaceID)
+				assertions.Nil(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// 40 requests with a burst of 20 and a refill of 20 per second
+	assertions.Len(requests, 40)
+	assertions.GreaterOrEqual(time.Since(start), 900*time.Millisecond)
+}
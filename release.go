@@ -0,0 +1,337 @@
+package contentful
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ReleasesService service
+type ReleasesService service
+
+// noinspection GoUnusedConst
+const (
+	// ReleaseActionPublish publishes every entity of the release
+	ReleaseActionPublish = "publish"
+
+	// ReleaseActionUnpublish unpublishes every entity of the release
+	ReleaseActionUnpublish = "unpublish"
+
+	// ReleaseActionValidate validates that every entity of the release can be published
+	ReleaseActionValidate = "validate"
+)
+
+// noinspection GoUnusedConst
+const (
+	// ReleaseActionStatusCreated release action waiting to be processed
+	ReleaseActionStatusCreated = "created"
+
+	// ReleaseActionStatusInProgress release action being processed
+	ReleaseActionStatusInProgress = "inProgress"
+
+	// ReleaseActionStatusSucceeded release action processed without errors
+	ReleaseActionStatusSucceeded = "succeeded"
+
+	// ReleaseActionStatusFailed release action which failed for at least one entity
+	ReleaseActionStatusFailed = "failed"
+)
+
+// MaxReleaseItems is the maximum number of entities of a release
+const MaxReleaseItems = 200
+
+// DefaultReleaseActionPollInterval is the interval between two polls of WaitAction
+const DefaultReleaseActionPollInterval = time.Second
+
+// Release model
+type Release struct {
+	Sys      *ReleaseSys      `json:"sys,omitempty"`
+	Title    string           `json:"title"`
+	Entities *ReleaseEntities `json:"entities"`
+}
+
+// ReleaseSys model
+type ReleaseSys struct {
+	ID         string `json:"id,omitempty"`
+	Type       string `json:"type,omitempty"`
+	Version    int    `json:"version,omitempty"`
+	Status     string `json:"status,omitempty"`
+	CreatedAt  string `json:"createdAt,omitempty"`
+	UpdatedAt  string `json:"updatedAt,omitempty"`
+	CreatedBy  *Sys   `json:"createdBy,omitempty"`
+	UpdatedBy  *Sys   `json:"updatedBy,omitempty"`
+	LastAction *Sys   `json:"lastAction,omitempty"`
+}
+
+// ReleaseEntities model, the links to the entries and assets of a release
+type ReleaseEntities struct {
+	Sys   *Sys     `json:"sys,omitempty"`
+	Items []Entity `json:"items"`
+}
+
+// ReleaseAction model
+type ReleaseAction struct {
+	Sys    *ReleaseActionSys   `json:"sys"`
+	Action string              `json:"action"`
+	Error  *ReleaseActionError `json:"error,omitempty"`
+}
+
+// ReleaseActionSys model
+type ReleaseActionSys struct {
+	ID        string  `json:"id,omitempty"`
+	Type      string  `json:"type,omitempty"`
+	Status    string  `json:"status,omitempty"`
+	CreatedAt string  `json:"createdAt,omitempty"`
+	UpdatedAt string  `json:"updatedAt,omitempty"`
+	CreatedBy *Sys    `json:"createdBy,omitempty"`
+	Release   *Entity `json:"release,omitempty"`
+}
+
+// ReleaseActionError model, it shares the error details of bulk actions
+type ReleaseActionError struct {
+	Sys     *Sys                    `json:"sys,omitempty"`
+	Message string                  `json:"message,omitempty"`
+	Details *BulkActionErrorDetails `json:"details,omitempty"`
+}
+
+func (e *ReleaseActionError) Error() string {
+	msg := strings.Builder{}
+	msg.WriteString("release action failed")
+	if e.Message != "" {
+		msg.WriteString(": " + e.Message)
+	}
+
+	if e.Details == nil {
+		return msg.String()
+	}
+
+	// the entity errors are formatted the same way as those of a bulk action
+	details := (&BulkActionError{Details: e.Details}).Error()
+	msg.WriteString(strings.TrimPrefix(details, "bulk action failed"))
+
+	return msg.String()
+}
+
+// NewRelease returns a release holding links to the given entries and assets
+func NewRelease(title string, entities []Entity) *Release {
+	return &Release{
+		Title:    title,
+		Entities: releaseEntities(entities),
+	}
+}
+
+// ReleaseLink returns the link to a release, used to schedule it
+func ReleaseLink(release *Release) Entity {
+	return EntityLink("Release", release.Sys.ID, 0)
+}
+
+// GetVersion returns release version
+func (release *Release) GetVersion() int {
+	version := 1
+	if release.Sys != nil {
+		version = release.Sys.Version
+	}
+
+	return version
+}
+
+// Done reports whether the release action is processed
+func (action *ReleaseAction) Done() bool {
+	return action.Sys != nil &&
+		(action.Sys.Status == ReleaseActionStatusSucceeded || action.Sys.Status == ReleaseActionStatusFailed)
+}
+
+// List returns releases collection
+func (service *ReleasesService) List(spaceID string) *Collection {
+	return service.c.environmentCollection(func(environment string) string {
+		return fmt.Sprintf("/spaces/%s/environments/%s/releases", spaceID, environment)
+	})
+}
+
+// Get returns a single release
+func (service *ReleasesService) Get(ctx context.Context, spaceID, releaseID string) (*Release, error) {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/releases/%s", spaceID, service.c.environment(ctx), releaseID)
+
+	req, err := service.c.newRequest(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var release Release
+	if err := service.c.do(req, &release); err != nil {
+		return nil, err
+	}
+
+	return &release, nil
+}
+
+// Entities returns the links to the entries and assets of a release
+func (service *ReleasesService) Entities(ctx context.Context, spaceID, releaseID string) ([]Entity, error) {
+	release, err := service.Get(ctx, spaceID, releaseID)
+	if err != nil {
+		return nil, err
+	}
+
+	if release.Entities == nil {
+		return nil, nil
+	}
+
+	return release.Entities.Items, nil
+}
+
+// Upsert updates or creates a new release
+func (service *ReleasesService) Upsert(ctx context.Context, spaceID string, release *Release) error {
+	if release.Entities == nil {
+		release.Entities = releaseEntities(nil)
+	}
+
+	if len(release.Entities.Items) > MaxReleaseItems {
+		return fmt.Errorf("contentful: a release holds at most %d entities, got %d", MaxReleaseItems, len(release.Entities.Items))
+	}
+
+	// only the title and the entities are writable
+	bytesArray, err := json.Marshal(&Release{Title: release.Title, Entities: release.Entities})
+	if err != nil {
+		return err
+	}
+
+	var path string
+	var method string
+
+	if release.Sys != nil && release.Sys.ID != "" {
+		path = fmt.Sprintf("/spaces/%s/environments/%s/releases/%s", spaceID, service.c.environment(ctx), release.Sys.ID)
+		method = "PUT"
+	} else {
+		path = fmt.Sprintf("/spaces/%s/environments/%s/releases", spaceID, service.c.environment(ctx))
+		method = "POST"
+	}
+
+	req, err := service.c.newRequest(ctx, method, path, nil, bytes.NewReader(bytesArray))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("X-Contentful-Version", strconv.Itoa(release.GetVersion()))
+
+	return service.c.do(req, release)
+}
+
+// Delete the release, the entries and assets it links to are kept
+func (service *ReleasesService) Delete(ctx context.Context, spaceID string, release *Release) error {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/releases/%s", spaceID, service.c.environment(ctx), release.Sys.ID)
+	method := "DELETE"
+
+	req, err := service.c.newRequest(ctx, method, path, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	version := strconv.Itoa(release.Sys.Version)
+	req.Header.Set("X-Contentful-Version", version)
+
+	return service.c.do(req, nil)
+}
+
+// Publish starts a release action publishing every entity of the release
+func (service *ReleasesService) Publish(ctx context.Context, spaceID string, release *Release) (*ReleaseAction, error) {
+	return service.action(ctx, spaceID, release, http.MethodPut, "published")
+}
+
+// Unpublish starts a release action unpublishing every entity of the release
+func (service *ReleasesService) Unpublish(ctx context.Context, spaceID string, release *Release) (*ReleaseAction, error) {
+	return service.action(ctx, spaceID, release, http.MethodDelete, "published")
+}
+
+// Validate starts a release action validating that every entity of the release can be published
+func (service *ReleasesService) Validate(ctx context.Context, spaceID string, release *Release) (*ReleaseAction, error) {
+	return service.action(ctx, spaceID, release, http.MethodPost, "validate")
+}
+
+// GetAction returns a single action of a release
+func (service *ReleasesService) GetAction(ctx context.Context, spaceID, releaseID, actionID string) (*ReleaseAction, error) {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/releases/%s/actions/%s", spaceID, service.c.environment(ctx), releaseID, actionID)
+
+	req, err := service.c.newRequest(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var action ReleaseAction
+	if err := service.c.do(req, &action); err != nil {
+		return nil, err
+	}
+
+	return &action, nil
+}
+
+// WaitAction polls the release action every interval, DefaultReleaseActionPollInterval
+// when zero, until it succeeded or failed. The error of a failed release action
+// is returned as a *ReleaseActionError, holding the errors of each entity.
+func (service *ReleasesService) WaitAction(ctx context.Context, spaceID string, action *ReleaseAction, interval time.Duration) (*ReleaseAction, error) {
+	if interval <= 0 {
+		interval = DefaultReleaseActionPollInterval
+	}
+
+	if action.Sys == nil || action.Sys.Release == nil {
+		return action, fmt.Errorf("contentful: release action has no release link")
+	}
+
+	for !action.Done() {
+		if err := sleep(ctx, interval); err != nil {
+			return action, err
+		}
+
+		current, err := service.GetAction(ctx, spaceID, action.Sys.Release.Sys.ID, action.Sys.ID)
+		if err != nil {
+			return action, err
+		}
+
+		action = current
+	}
+
+	if action.Sys.Status == ReleaseActionStatusFailed {
+		if action.Error == nil {
+			return action, &ReleaseActionError{}
+		}
+
+		return action, action.Error
+	}
+
+	return action, nil
+}
+
+func (service *ReleasesService) action(ctx context.Context, spaceID string, release *Release, method, endpoint string) (*ReleaseAction, error) {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/releases/%s/%s", spaceID, service.c.environment(ctx), release.Sys.ID, endpoint)
+
+	req, err := service.c.newRequest(ctx, method, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("X-Contentful-Version", strconv.Itoa(release.GetVersion()))
+
+	var action ReleaseAction
+	if err := service.c.do(req, &action); err != nil {
+		return nil, err
+	}
+
+	return &action, nil
+}
+
+// releaseEntities wraps links into an array, releases always point to the latest versions
+func releaseEntities(entities []Entity) *ReleaseEntities {
+	items := make([]Entity, len(entities))
+	for i, entity := range entities {
+		items[i] = entity
+		items[i].Sys.Version = 0
+	}
+
+	return &ReleaseEntities{
+		Sys:   &Sys{Type: "Array"},
+		Items: items,
+	}
+}
@@ -0,0 +1,357 @@
+package contentful
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReleasesService_List(t *testing.T) {
+	var err error
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "GET")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/master/releases")
+
+		checkHeaders(r, assertions)
+
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("releases.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.baseURL = server.URL
+
+	collection, err := cma.Releases.List(spaceID).Next(context.Background())
+	assertions.Nil(err)
+	releases := collection.ToRelease()
+	assertions.Equal(1, len(releases))
+	assertions.Equal("Autumn campaign", releases[0].Title)
+}
+
+func TestReleasesService_Entities(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "GET")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/master/releases/release-id")
+
+		checkHeaders(r, assertions)
+
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("release.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.baseURL = server.URL
+
+	entities, err := cma.Releases.Entities(context.Background(), spaceID, "release-id")
+	assertions.Nil(err)
+	assertions.Len(entities, 2)
+	assertions.Equal("Entry", entities[0].Sys.LinkType)
+	assertions.Equal("1x0xpXu4pSGS4OukSyWGUK", entities[1].Sys.ID)
+}
+
+func TestReleasesService_Upsert_Create(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "POST")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/master/releases")
+
+		checkHeaders(r, assertions)
+
+		var payload map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&payload)
+		assertions.Nil(err)
+		assertions.Equal("Autumn campaign", payload["title"])
+		assertions.Nil(payload["sys"])
+
+		items := payload["entities"].(map[string]interface{})["items"].([]interface{})
+		assertions.Len(items, 2)
+
+		// releases always point to the latest versions
+		sys := items[0].(map[string]interface{})["sys"].(map[string]interface{})
+		assertions.Equal("5KsDBWseXY6QegucYAoacS", sys["id"])
+		assertions.Nil(sys["version"])
+
+		w.WriteHeader(201)
+		_, _ = fmt.Fprintln(w, readTestData("release.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.baseURL = server.URL
+
+	entry := &Entry{Sys: &Sys{ID: "5KsDBWseXY6QegucYAoacS", Version: 3}}
+	asset := &Asset{Sys: &Sys{ID: "1x0xpXu4pSGS4OukSyWGUK", Version: 7}}
+
+	release := NewRelease("Autumn campaign", []Entity{EntryLink(entry), AssetLink(asset)})
+	err := cma.Releases.Upsert(context.Background(), spaceID, release)
+	assertions.Nil(err)
+	assertions.Equal("release-id", release.Sys.ID)
+	assertions.Equal(1, release.Sys.Version)
+}
+
+func TestReleasesService_Upsert_Update(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "PUT")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/master/releases/release-id")
+		assertions.Equal("1", r.Header.Get("X-Contentful-Version"))
+
+		checkHeaders(r, assertions)
+
+		var payload map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&payload)
+		assertions.Nil(err)
+		assertions.Equal("Winter campaign", payload["title"])
+
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("release.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.baseURL = server.URL
+
+	release, err := releaseFromTestFile("release.json")
+	assertions.Nil(err)
+
+	release.Title = "Winter campaign"
+	err = cma.Releases.Upsert(context.Background(), spaceID, release)
+	assertions.Nil(err)
+}
+
+func TestReleasesService_TooManyItems(t *testing.T) {
+	assertions := assert.New(t)
+
+	cma = NewCMA(CMAToken)
+
+	release := NewRelease("Too large", make([]Entity, MaxReleaseItems+1))
+	err := cma.Releases.Upsert(context.Background(), spaceID, release)
+	assertions.EqualError(err, "contentful: a release holds at most 200 entities, got 201")
+}
+
+func TestReleasesService_Delete(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "DELETE")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/master/releases/release-id")
+
+		checkHeaders(r, assertions)
+
+		w.WriteHeader(204)
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.baseURL = server.URL
+
+	release, err := releaseFromTestFile("release.json")
+	assertions.Nil(err)
+
+	err = cma.Releases.Delete(context.Background(), spaceID, release)
+	assertions.Nil(err)
+}
+
+func TestReleasesService_Actions(t *testing.T) {
+	assertions := assert.New(t)
+
+	var requests []string
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		assertions.Equal("1", r.Header.Get("X-Contentful-Version"))
+
+		checkHeaders(r, assertions)
+
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("release_action.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.baseURL = server.URL
+
+	release, err := releaseFromTestFile("release.json")
+	assertions.Nil(err)
+
+	action, err := cma.Releases.Publish(context.Background(), spaceID, release)
+	assertions.Nil(err)
+	assertions.Equal("release-action-id", action.Sys.ID)
+	assertions.Equal("release-id", action.Sys.Release.Sys.ID)
+	assertions.False(action.Done())
+
+	_, err = cma.Releases.Unpublish(context.Background(), spaceID, release)
+	assertions.Nil(err)
+
+	_, err = cma.Releases.Validate(context.Background(), spaceID, release)
+	assertions.Nil(err)
+
+	path := "/spaces/" + spaceID + "/environments/master/releases/release-id"
+	assertions.Equal([]string{
+		"PUT " + path + "/published",
+		"DELETE " + path + "/published",
+		"POST " + path + "/validate",
+	}, requests)
+}
+
+func TestReleasesService_WaitAction(t *testing.T) {
+	assertions := assert.New(t)
+
+	polls := 0
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "GET")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/master/releases/release-id/actions/release-action-id")
+
+		checkHeaders(r, assertions)
+
+		polls++
+		w.WriteHeader(200)
+		if polls < 2 {
+			_, _ = fmt.Fprintln(w, readTestData("release_action.json"))
+			return
+		}
+
+		_, _ = fmt.Fprintln(w, readTestData("release_action_succeeded.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.baseURL = server.URL
+
+	action := &ReleaseAction{Sys: &ReleaseActionSys{
+		ID:      "release-action-id",
+		Status:  ReleaseActionStatusCreated,
+		Release: &Entity{Sys: Sys{ID: "release-id"}},
+	}}
+
+	action, err := cma.Releases.WaitAction(context.Background(), spaceID, action, 1)
+	assertions.Nil(err)
+	assertions.Equal(2, polls)
+	assertions.Equal(ReleaseActionStatusSucceeded, action.Sys.Status)
+}
+
+func TestReleasesService_WaitActionFailed(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("release_action_failed.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.baseURL = server.URL
+
+	action := &ReleaseAction{Sys: &ReleaseActionSys{
+		ID:      "release-action-id",
+		Status:  ReleaseActionStatusInProgress,
+		Release: &Entity{Sys: Sys{ID: "release-id"}},
+	}}
+
+	action, err := cma.Releases.WaitAction(context.Background(), spaceID, action, 1)
+	assertions.NotNil(err)
+	assertions.Equal(ReleaseActionStatusFailed, action.Sys.Status)
+
+	var releaseActionError *ReleaseActionError
+	assertions.True(errors.As(err, &releaseActionError))
+	assertions.Len(releaseActionError.Details.Errors, 1)
+	assertions.Equal("release action failed: Release action failed\nEntry 5KsDBWseXY6QegucYAoacS: InvalidEntry, The property \"en-US\" is required here", err.Error())
+}
+
+func TestScheduledActionsService_ScheduleRelease(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "POST")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/scheduled_actions")
+		assertions.Equal("release-id", r.URL.Query().Get("entity.sys.id"))
+		assertions.Equal("staging", r.URL.Query().Get("environment.sys.id"))
+
+		checkHeaders(r, assertions)
+
+		var payload ScheduledAction
+		err := json.NewDecoder(r.Body).Decode(&payload)
+		assertions.Nil(err)
+		assertions.Equal("Release", payload.Entity.Sys.LinkType)
+		assertions.Equal("release-id", payload.Entity.Sys.ID)
+		assertions.Equal("staging", payload.Environment.Sys.ID)
+		assertions.Equal("2119-09-02T14:00:00.000Z", payload.ScheduledFor["datetime"])
+		assertions.Equal(ReleaseActionPublish, payload.Action)
+
+		w.WriteHeader(201)
+		_, _ = fmt.Fprintln(w, readTestData("scheduled_action_created.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.baseURL = server.URL
+
+	release, err := releaseFromTestFile("release.json")
+	assertions.Nil(err)
+
+	scheduledFor := time.Date(2119, 9, 2, 16, 0, 0, 0, time.FixedZone("CEST", 2*60*60))
+	ctx := WithEnvironment(context.Background(), "staging")
+
+	scheduledAction, err := cma.ScheduledActions.ScheduleRelease(ctx, spaceID, release, ReleaseActionPublish, scheduledFor)
+	assertions.Nil(err)
+	assertions.Equal("3A13SXSDwO8c46NrjigFYT", scheduledAction.Sys.ID)
+}
+
+func releaseFromTestFile(fileName string) (*Release, error) {
+	var release Release
+	if err := json.Unmarshal([]byte(readTestData(fileName)), &release); err != nil {
+		return nil, err
+	}
+
+	return &release, nil
+}
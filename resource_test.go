@@ -1,6 +1,7 @@
 package contentful
 
 import (
+	"context"
 	"fmt"
 	"github.com/stretchr/testify/assert"
 	"net/http"
@@ -27,9 +28,9 @@ func TestResourcesService_Get(t *testing.T) {
 
 	// cma client
 	urc = NewResourceClient(CMAToken)
-	urc.BaseURL = server.URL
+	urc.baseURL = server.URL
 
-	resource, err := urc.Resources.Get(spaceID, "0xvkNW6WdQ8JkWlWZ8BC4x")
+	resource, err := urc.Resources.Get(context.Background(), spaceID, "0xvkNW6WdQ8JkWlWZ8BC4x")
 	assertions.Nil(err)
 	assertions.Equal("2015-05-18T11:29:46.809Z", resource.Sys.CreatedAt)
 	assertions.Equal("yadj1kx9rmg0", resource.Sys.Space.Sys.ID)
@@ -53,10 +54,11 @@ func TestResourcesService_Get_2(t *testing.T) {
 
 	// cma client
 	urc = NewResourceClient(CMAToken)
-	urc.BaseURL = server.URL
+	urc.baseURL = server.URL
 
-	_, err = urc.Resources.Get(spaceID, "0xvkNW6WdQ8JkWlWZ8BC4x")
-	assertions.Nil(err)
+	resource, err := urc.Resources.Get(context.Background(), spaceID, "0xvkNW6WdQ8JkWlWZ8BC4x")
+	assertions.NotNil(err)
+	assertions.Nil(resource)
 }
 
 func TestResourcesService_Create(t *testing.T) {
@@ -74,12 +76,12 @@ func TestResourcesService_Create(t *testing.T) {
 
 	// cma client
 	urc = NewResourceClient(CMAToken)
-	urc.BaseURL = server.URL
+	urc.baseURL = server.URL
 
 	curPath, _ := filepath.Abs("./resource_test.go")
 	absolutePath := curPath[:len(curPath)-16]
 
-	err = urc.Resources.Create(spaceID, absolutePath+"testdata/resource_uploaded.png")
+	err = urc.Resources.Create(context.Background(), spaceID, absolutePath+"testdata/resource_uploaded.png")
 	assertions.Nil(err)
 }
 
@@ -100,14 +102,14 @@ func TestResourcesService_Delete(t *testing.T) {
 
 	// cma client
 	urc = NewResourceClient(CMAToken)
-	urc.BaseURL = server.URL
+	urc.baseURL = server.URL
 
 	// test role
 	resource, err := resourceFromTestFile("resource_1.json")
 	assertions.Nil(err)
 
 	// delete role
-	err = urc.Resources.Delete(spaceID, resource.Sys.ID)
+	err = urc.Resources.Delete(context.Background(), spaceID, resource.Sys.ID)
 	assertions.Nil(err)
 }
 
@@ -128,13 +130,13 @@ func TestResourcesService_Delete_2(t *testing.T) {
 
 	// cma client
 	urc = NewResourceClient(CMAToken)
-	urc.BaseURL = server.URL
+	urc.baseURL = server.URL
 
 	// test role
 	resource, err := resourceFromTestFile("resource_1.json")
 	assertions.Nil(err)
 
 	// delete role
-	err = urc.Resources.Delete(spaceID, resource.Sys.ID)
+	err = urc.Resources.Delete(context.Background(), spaceID, resource.Sys.ID)
 	assertions.NotNil(err)
 }
@@ -0,0 +1,223 @@
+package contentful
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// RetryPolicy controls how failed requests are retried by the client.
+// Requests whose body can not be rewound are never retried.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	// A value of 1 disables retries.
+	MaxAttempts int
+
+	// MinBackoff is the base delay of the exponential backoff
+	MinBackoff time.Duration
+
+	// MaxBackoff caps the computed backoff delay
+	MaxBackoff time.Duration
+
+	// RetryableStatusCodes lists the response status codes which are retried
+	RetryableStatusCodes []int
+
+	// RetryOnNetworkError retries connection resets, unexpected EOFs and timeouts
+	RetryOnNetworkError bool
+
+	// RetryNonIdempotent retries POST and PATCH requests after network errors
+	// and server errors. The api may have processed them already, so a retry
+	// may e.g. create an entry twice; by default they are only retried after
+	// rate limit errors.
+	RetryNonIdempotent bool
+
+	// MaxRetryAfter caps the waits given by the Retry-After and
+	// X-Contentful-Ratelimit-Reset headers, zero caps them at MaxBackoff
+	MaxRetryAfter time.Duration
+}
+
+// DefaultRetryPolicy returns the policy used when the client has none configured
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 5,
+		MinBackoff:  500 * time.Millisecond,
+		MaxBackoff:  30 * time.Second,
+		RetryableStatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+		RetryOnNetworkError: true,
+		MaxRetryAfter:       time.Minute,
+	}
+}
+
+// NoRetryPolicy returns a policy which never retries
+func NoRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{MaxAttempts: 1}
+}
+
+// idempotent reports whether a request with the given method may be sent
+// again although the api may have processed it
+func (p *RetryPolicy) idempotent(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPatch:
+		return p.RetryNonIdempotent
+	default:
+		return true
+	}
+}
+
+// retryableStatus reports whether a response with the given status code is
+// retried. Rate limited requests were not processed, so they are retried
+// whatever their method.
+func (p *RetryPolicy) retryableStatus(method string, code int) bool {
+	if code != http.StatusTooManyRequests && !p.idempotent(method) {
+		return false
+	}
+
+	for _, c := range p.RetryableStatusCodes {
+		if c == code {
+			return true
+		}
+	}
+
+	return false
+}
+
+// retryableError reports whether a transport error is retried
+func (p *RetryPolicy) retryableError(method string, err error) bool {
+	if !p.RetryOnNetworkError || !p.idempotent(method) {
+		return false
+	}
+
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	if errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) {
+		return true
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	return false
+}
+
+// delay returns the wait before the next attempt. The server supplied
+// Retry-After and X-Contentful-Ratelimit-Reset headers, capped at
+// MaxRetryAfter, take precedence over the exponential backoff, which uses
+// full jitter.
+func (p *RetryPolicy) delay(attempt int, res *http.Response) time.Duration {
+	if res != nil {
+		if d, ok := parseRetryAfter(res.Header.Get("Retry-After")); ok {
+			return p.capRetryAfter(d)
+		}
+
+		if seconds, err := strconv.Atoi(res.Header.Get("X-Contentful-Ratelimit-Reset")); err == nil && seconds >= 0 {
+			return p.capRetryAfter(time.Duration(seconds) * time.Second)
+		}
+	}
+
+	backoff := p.MinBackoff << uint(attempt-1)
+	if backoff <= 0 || (p.MaxBackoff > 0 && backoff > p.MaxBackoff) {
+		backoff = p.MaxBackoff
+	}
+
+	if backoff <= 0 {
+		return 0
+	}
+
+	return time.Duration(rand.Int63n(int64(backoff) + 1))
+}
+
+// capRetryAfter caps a wait given by the server
+func (p *RetryPolicy) capRetryAfter(d time.Duration) time.Duration {
+	limit := p.MaxRetryAfter
+	if limit <= 0 {
+		limit = p.MaxBackoff
+	}
+
+	if limit > 0 && d > limit {
+		return limit
+	}
+
+	return d
+}
+
+// parseRetryAfter parses a Retry-After header given in seconds or as an HTTP date
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		d := time.Until(date)
+		if d < 0 {
+			d = 0
+		}
+
+		return d, true
+	}
+
+	return 0, false
+}
+
+// sleep waits for the given duration, or until the context is done
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// rewindable reports whether the request body can be sent again, requests
+// whose body can not be rewound are not retried
+func rewindable(req *http.Request) bool {
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+// rewindBody resets the request body so that the request can be sent again
+func rewindBody(req *http.Request) error {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil
+	}
+
+	if !rewindable(req) {
+		return errors.New("contentful: request body can not be rewound for retry")
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return err
+	}
+
+	req.Body = body
+
+	return nil
+}
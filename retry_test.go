@@ -0,0 +1,234 @@
+package contentful
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func fastRetryPolicy(maxAttempts int) *RetryPolicy {
+	policy := DefaultRetryPolicy()
+	policy.MaxAttempts = maxAttempts
+	policy.MinBackoff = time.Millisecond
+	policy.MaxBackoff = 5 * time.Millisecond
+
+	return policy
+}
+
+func TestRetryOnServerError(t *testing.T) {
+	assertions := assert.New(t)
+	var attempts int32
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "PUT")
+
+		body, err := io.ReadAll(r.Body)
+		assertions.Nil(err)
+		assertions.Contains(string(body), "Hello, World!")
+
+		if atomic.AddInt32(&attempts, 1) < 3 {
+			w.WriteHeader(503)
+			_, _ = fmt.Fprintln(w, `{"sys":{"type":"Error","id":"ServiceUnavailable"}}`)
+			return
+		}
+
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("entry_1.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.baseURL = server.URL
+	cma.SetRetryPolicy(fastRetryPolicy(3))
+
+	entry := &Entry{
+		Sys: &Sys{
+			ID:      "5KsDBWseXY6QegucYAoacS",
+			Version: 1,
+		},
+		Fields: map[string]interface{}{
+			"title": map[string]interface{}{
+				"en-US": "Hello, World!",
+			},
+		},
+	}
+
+	err := cma.Entries.Upsert(context.Background(), spaceID, "hfM9RCJIk0wIm06WkEOQY", entry)
+	assertions.Nil(err)
+	assertions.Equal(int32(3), atomic.LoadInt32(&attempts))
+}
+
+func TestRetryMaxAttempts(t *testing.T) {
+	assertions := assert.New(t)
+	var attempts int32
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(429)
+		_, _ = fmt.Fprintln(w, readTestData("error_ratelimit.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.baseURL = server.URL
+	cma.SetRetryPolicy(fastRetryPolicy(2))
+
+	_, err := cma.Spaces.Get(context.Background(), spaceID)
+	assertions.IsType(RateLimitExceededError{}, err)
+	assertions.Equal(int32(2), atomic.LoadInt32(&attempts))
+}
+
+func TestNoRetryPolicy(t *testing.T) {
+	assertions := assert.New(t)
+	var attempts int32
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(429)
+		_, _ = fmt.Fprintln(w, readTestData("error_ratelimit.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.baseURL = server.URL
+	cma.SetRetryPolicy(NoRetryPolicy())
+
+	_, err := cma.Spaces.Get(context.Background(), spaceID)
+	assertions.IsType(RateLimitExceededError{}, err)
+	assertions.Equal(int32(1), atomic.LoadInt32(&attempts))
+}
+
+func TestRetryBodyNotRewindable(t *testing.T) {
+	assertions := assert.New(t)
+	var attempts int32
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(503)
+		_, _ = fmt.Fprintln(w, `{"sys":{"type":"Error","id":"ServiceUnavailable"},"message":"unavailable"}`)
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client, whose backoff would outlast the test
+	cma = NewCMA(CMAToken, WithBaseURL(server.URL), WithRateLimiter(nil))
+	policy := DefaultRetryPolicy()
+	policy.MinBackoff = time.Minute
+	cma.SetRetryPolicy(policy)
+
+	// a reader which http.NewRequest can not rewind
+	body := io.MultiReader(strings.NewReader(`{"fields":{}}`))
+	req, err := cma.newRequest(context.Background(), "PUT", "/spaces/"+spaceID+"/environments/master/entries/1", nil, body)
+	assertions.Nil(err)
+	assertions.Nil(req.GetBody)
+
+	// the error of the api is returned at once
+	err = cma.do(req, nil)
+	assertions.IsType(ErrorResponse{}, err)
+	assertions.Equal("unavailable", err.(ErrorResponse).Message)
+	assertions.Equal(int32(1), atomic.LoadInt32(&attempts))
+}
+
+func TestRetryNonIdempotent(t *testing.T) {
+	assertions := assert.New(t)
+	var attempts int32
+	status := 503
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "POST")
+		atomic.AddInt32(&attempts, 1)
+
+		w.WriteHeader(status)
+		if status == 429 {
+			_, _ = fmt.Fprintln(w, readTestData("error_ratelimit.json"))
+			return
+		}
+
+		_, _ = fmt.Fprintln(w, `{"sys":{"type":"Error","id":"ServiceUnavailable"}}`)
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken, WithBaseURL(server.URL), WithRateLimiter(nil), WithRetryPolicy(fastRetryPolicy(3)))
+
+	entry := &Entry{Fields: map[string]interface{}{"title": map[string]interface{}{"en-US": "Hello, World!"}}}
+
+	// a created entry may have been processed by the server
+	err := cma.Entries.Upsert(context.Background(), spaceID, "hfM9RCJIk0wIm06WkEOQY", entry)
+	assertions.NotNil(err)
+	assertions.Equal(int32(1), atomic.LoadInt32(&attempts))
+
+	// rate limited requests were not processed
+	status = 429
+	atomic.StoreInt32(&attempts, 0)
+	err = cma.Entries.Upsert(context.Background(), spaceID, "hfM9RCJIk0wIm06WkEOQY", entry)
+	assertions.IsType(RateLimitExceededError{}, err)
+	assertions.Equal(int32(3), atomic.LoadInt32(&attempts))
+
+	// unless opted in
+	policy := fastRetryPolicy(3)
+	policy.RetryNonIdempotent = true
+	cma.SetRetryPolicy(policy)
+
+	status = 503
+	atomic.StoreInt32(&attempts, 0)
+	err = cma.Entries.Upsert(context.Background(), spaceID, "hfM9RCJIk0wIm06WkEOQY", entry)
+	assertions.NotNil(err)
+	assertions.Equal(int32(3), atomic.LoadInt32(&attempts))
+}
+
+func TestRetryPolicyDelay(t *testing.T) {
+	assertions := assert.New(t)
+	policy := fastRetryPolicy(5)
+
+	res := &http.Response{Header: http.Header{}}
+	res.Header.Set("Retry-After", "3")
+	assertions.Equal(3*time.Second, policy.delay(1, res))
+
+	res.Header.Del("Retry-After")
+	res.Header.Set("X-Contentful-Ratelimit-Reset", "2")
+	assertions.Equal(2*time.Second, policy.delay(1, res))
+
+	// waits given by the server are capped
+	res.Header.Set("X-Contentful-Ratelimit-Reset", "3600")
+	assertions.Equal(time.Minute, policy.delay(1, res))
+
+	policy.MaxRetryAfter = 0
+	assertions.Equal(policy.MaxBackoff, policy.delay(1, res))
+
+	for attempt := 1; attempt < 10; attempt++ {
+		assertions.LessOrEqual(policy.delay(attempt, nil), policy.MaxBackoff)
+	}
+
+	d, ok := parseRetryAfter(time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat))
+	assertions.True(ok)
+	assertions.Equal(time.Duration(0), d)
+
+	_, ok = parseRetryAfter("soon")
+	assertions.False(ok)
+}
@@ -0,0 +1,307 @@
+package contentful
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// noinspection GoUnusedConst
+const (
+	// NodeTypeDocument rich text root node
+	NodeTypeDocument = "document"
+
+	// NodeTypeParagraph rich text paragraph node
+	NodeTypeParagraph = "paragraph"
+
+	// NodeTypeHeading1 rich text heading node of level 1
+	NodeTypeHeading1 = "heading-1"
+
+	// NodeTypeHeading2 rich text heading node of level 2
+	NodeTypeHeading2 = "heading-2"
+
+	// NodeTypeHeading3 rich text heading node of level 3
+	NodeTypeHeading3 = "heading-3"
+
+	// NodeTypeHeading4 rich text heading node of level 4
+	NodeTypeHeading4 = "heading-4"
+
+	// NodeTypeHeading5 rich text heading node of level 5
+	NodeTypeHeading5 = "heading-5"
+
+	// NodeTypeHeading6 rich text heading node of level 6
+	NodeTypeHeading6 = "heading-6"
+
+	// NodeTypeOrderedList rich text ordered list node
+	NodeTypeOrderedList = "ordered-list"
+
+	// NodeTypeUnorderedList rich text unordered list node
+	NodeTypeUnorderedList = "unordered-list"
+
+	// NodeTypeListItem rich text list item node
+	NodeTypeListItem = "list-item"
+
+	// NodeTypeBlockquote rich text quote node
+	NodeTypeBlockquote = "blockquote"
+
+	// NodeTypeHr rich text horizontal rule node
+	NodeTypeHr = "hr"
+
+	// NodeTypeTable rich text table node
+	NodeTypeTable = "table"
+
+	// NodeTypeTableRow rich text table row node
+	NodeTypeTableRow = "table-row"
+
+	// NodeTypeTableCell rich text table cell node
+	NodeTypeTableCell = "table-cell"
+
+	// NodeTypeTableHeaderCell rich text table header cell node
+	NodeTypeTableHeaderCell = "table-header-cell"
+
+	// NodeTypeEmbeddedEntryBlock rich text node embedding an entry as a block
+	NodeTypeEmbeddedEntryBlock = "embedded-entry-block"
+
+	// NodeTypeEmbeddedEntryInline rich text node embedding an entry inline
+	NodeTypeEmbeddedEntryInline = "embedded-entry-inline"
+
+	// NodeTypeEmbeddedAssetBlock rich text node embedding an asset as a block
+	NodeTypeEmbeddedAssetBlock = "embedded-asset-block"
+
+	// NodeTypeHyperlink rich text link to an uri
+	NodeTypeHyperlink = "hyperlink"
+
+	// NodeTypeEntryHyperlink rich text link to an entry
+	NodeTypeEntryHyperlink = "entry-hyperlink"
+
+	// NodeTypeAssetHyperlink rich text link to an asset
+	NodeTypeAssetHyperlink = "asset-hyperlink"
+
+	// NodeTypeText rich text text node
+	NodeTypeText = "text"
+)
+
+// noinspection GoUnusedConst
+const (
+	// MarkBold bold text
+	MarkBold = "bold"
+
+	// MarkItalic italic text
+	MarkItalic = "italic"
+
+	// MarkUnderline underlined text
+	MarkUnderline = "underline"
+
+	// MarkCode code text
+	MarkCode = "code"
+
+	// MarkSuperscript superscript text
+	MarkSuperscript = "superscript"
+
+	// MarkSubscript subscript text
+	MarkSubscript = "subscript"
+
+	// MarkStrikethrough strikethrough text
+	MarkStrikethrough = "strikethrough"
+)
+
+// Document is the root node of a rich text field
+type Document struct {
+	Data    map[string]interface{}
+	Content []*Node
+}
+
+// Node is a rich text node. Text nodes hold a Value and Marks, other nodes
+// hold Content. The Data of embedded and hyperlink nodes holds their target,
+// either a link or the *Entry or *Asset it was resolved to, and the uri of
+// hyperlinks.
+type Node struct {
+	NodeType string                 `json:"nodeType"`
+	Data     map[string]interface{} `json:"data"`
+	Content  []*Node                `json:"content,omitempty"`
+	Value    string                 `json:"value,omitempty"`
+	Marks    []Mark                 `json:"marks,omitempty"`
+}
+
+// Mark is the formatting of a text node
+type Mark struct {
+	Type string `json:"type"`
+}
+
+// ParseRichText converts the value of a RichText field into a Document. Links
+// which were resolved by Collection.ResolveLinks are kept as *Entry and *Asset.
+func ParseRichText(value interface{}) (*Document, error) {
+	switch v := value.(type) {
+	case *Document:
+		return v, nil
+	case Document:
+		return &v, nil
+	case map[string]interface{}:
+		node, err := parseNode(v)
+		if err != nil {
+			return nil, err
+		}
+
+		if node.NodeType != NodeTypeDocument {
+			return nil, fmt.Errorf("contentful: rich text root node is a %q, not a document", node.NodeType)
+		}
+
+		return &Document{Data: node.Data, Content: node.Content}, nil
+	}
+
+	byteArray, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc Document
+	if err := json.Unmarshal(byteArray, &doc); err != nil {
+		return nil, err
+	}
+
+	return &doc, nil
+}
+
+// parseNode converts a decoded json node, keeping the values of its data as is
+func parseNode(value map[string]interface{}) (*Node, error) {
+	node := &Node{}
+	node.NodeType, _ = value["nodeType"].(string)
+	if node.NodeType == "" {
+		return nil, fmt.Errorf("contentful: rich text node without nodeType")
+	}
+
+	node.Data, _ = value["data"].(map[string]interface{})
+	node.Value, _ = value["value"].(string)
+
+	if marks, ok := value["marks"].([]interface{}); ok {
+		for _, mark := range marks {
+			if m, ok := mark.(map[string]interface{}); ok {
+				markType, _ := m["type"].(string)
+				node.Marks = append(node.Marks, Mark{Type: markType})
+			}
+		}
+	}
+
+	if content, ok := value["content"].([]interface{}); ok {
+		for _, item := range content {
+			child, ok := item.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("contentful: invalid rich text node %v", item)
+			}
+
+			childNode, err := parseNode(child)
+			if err != nil {
+				return nil, err
+			}
+
+			node.Content = append(node.Content, childNode)
+		}
+	}
+
+	return node, nil
+}
+
+// MarshalJSON for custom json marshaling
+func (doc Document) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&Node{
+		NodeType: NodeTypeDocument,
+		Data:     doc.Data,
+		Content:  doc.Content,
+	})
+}
+
+// UnmarshalJSON for custom json unmarshaling
+func (doc *Document) UnmarshalJSON(data []byte) error {
+	var node Node
+	if err := json.Unmarshal(data, &node); err != nil {
+		return err
+	}
+
+	if node.NodeType != NodeTypeDocument {
+		return fmt.Errorf("contentful: rich text root node is a %q, not a document", node.NodeType)
+	}
+
+	doc.Data = node.Data
+	doc.Content = node.Content
+
+	return nil
+}
+
+// MarshalJSON for custom json marshaling. Nodes are written like the api does,
+// with an empty data object, and empty content or marks.
+func (node Node) MarshalJSON() ([]byte, error) {
+	data := node.Data
+	if data == nil {
+		data = map[string]interface{}{}
+	}
+
+	if node.NodeType == NodeTypeText {
+		marks := node.Marks
+		if marks == nil {
+			marks = []Mark{}
+		}
+
+		return json.Marshal(struct {
+			NodeType string                 `json:"nodeType"`
+			Value    string                 `json:"value"`
+			Marks    []Mark                 `json:"marks"`
+			Data     map[string]interface{} `json:"data"`
+		}{node.NodeType, node.Value, marks, data})
+	}
+
+	content := node.Content
+	if content == nil {
+		content = []*Node{}
+	}
+
+	return json.Marshal(struct {
+		NodeType string                 `json:"nodeType"`
+		Data     map[string]interface{} `json:"data"`
+		Content  []*Node                `json:"content"`
+	}{node.NodeType, data, content})
+}
+
+// HasMark reports whether the text node has the given mark
+func (node *Node) HasMark(markType string) bool {
+	for _, mark := range node.Marks {
+		if mark.Type == markType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// URI returns the uri of a hyperlink node
+func (node *Node) URI() string {
+	uri, _ := node.Data["uri"].(string)
+	return uri
+}
+
+// Target returns the link type and id of the target of an embedded or
+// hyperlink node, resolved or not
+func (node *Node) Target() (linkType, id string, ok bool) {
+	switch target := node.Data["target"].(type) {
+	case *Entry:
+		if target.Sys != nil {
+			return "Entry", target.Sys.ID, true
+		}
+	case *Asset:
+		if target.Sys != nil {
+			return "Asset", target.Sys.ID, true
+		}
+	case map[string]interface{}:
+		if linkType, id, ok := parseLink(target); ok {
+			return linkType, id, true
+		}
+
+		// a resolved target which went through json
+		sys, _ := target["sys"].(map[string]interface{})
+		linkType, _ = sys["type"].(string)
+		id, _ = sys["id"].(string)
+		if (linkType == "Entry" || linkType == "Asset") && id != "" {
+			return linkType, id, true
+		}
+	}
+
+	return "", "", false
+}
@@ -0,0 +1,497 @@
+package contentful
+
+import (
+	"fmt"
+	"html"
+	"strings"
+)
+
+// NodeRenderer renders a rich text node. Renderers of container nodes render
+// their children with RichTextRenderer.RenderNodes.
+type NodeRenderer func(r *RichTextRenderer, node *Node) string
+
+// MarkRenderer wraps the rendered text of a text node with a mark
+type MarkRenderer func(text string) string
+
+// RichTextResolver resolves the targets of embedded and hyperlink nodes which
+// are not resolved yet, e.g. from the includes of a collection
+type RichTextResolver interface {
+	ResolveLink(linkType, id string) (interface{}, bool)
+}
+
+// RichTextResolverFunc adapts a function to a RichTextResolver
+type RichTextResolverFunc func(linkType, id string) (interface{}, bool)
+
+// ResolveLink calls f
+func (f RichTextResolverFunc) ResolveLink(linkType, id string) (interface{}, bool) {
+	return f(linkType, id)
+}
+
+// RichTextRenderer renders rich text documents, see NewHTMLRenderer and
+// NewMarkdownRenderer. The rendering of node types and marks can be
+// overridden through Nodes and Marks.
+//
+// Embedded entries are not rendered by default, as their rendering depends on
+// their content type:
+//
+//	renderer := contentful.NewHTMLRenderer()
+//	renderer.Resolver = col.Includes
+//	renderer.Nodes[contentful.NodeTypeEmbeddedEntryBlock] = func(r *contentful.RichTextRenderer, node *contentful.Node) string {
+//		entry, ok := r.ResolveEntry(node)
+//		...
+//	}
+type RichTextRenderer struct {
+	// Nodes overrides the rendering of node types, keyed by node type
+	Nodes map[string]NodeRenderer
+
+	// Marks overrides the rendering of marks, keyed by mark type
+	Marks map[string]MarkRenderer
+
+	// Resolver resolves the targets of embedded and hyperlink nodes
+	Resolver RichTextResolver
+
+	// Locale is the locale of the fields of resolved assets, it defaults to the locale of the asset
+	Locale string
+
+	defaultNodes map[string]NodeRenderer
+	defaultMarks map[string]MarkRenderer
+	fallback     NodeRenderer
+}
+
+// Render renders a document
+func (r *RichTextRenderer) Render(doc *Document) string {
+	if doc == nil {
+		return ""
+	}
+
+	return r.RenderNode(&Node{NodeType: NodeTypeDocument, Data: doc.Data, Content: doc.Content})
+}
+
+// RenderNodes renders a list of nodes
+func (r *RichTextRenderer) RenderNodes(nodes []*Node) string {
+	var b strings.Builder
+	for _, node := range nodes {
+		b.WriteString(r.RenderNode(node))
+	}
+
+	return b.String()
+}
+
+// RenderNode renders a node, using the renderer of its type
+func (r *RichTextRenderer) RenderNode(node *Node) string {
+	if render, ok := r.Nodes[node.NodeType]; ok {
+		return render(r, node)
+	}
+
+	if render, ok := r.defaultNodes[node.NodeType]; ok {
+		return render(r, node)
+	}
+
+	return r.fallback(r, node)
+}
+
+// RenderMarks wraps text with the marks of a text node
+func (r *RichTextRenderer) RenderMarks(text string, marks []Mark) string {
+	for _, mark := range marks {
+		if render, ok := r.Marks[mark.Type]; ok {
+			text = render(text)
+		} else if render, ok := r.defaultMarks[mark.Type]; ok {
+			text = render(text)
+		}
+	}
+
+	return text
+}
+
+// Resolve returns the target of an embedded or hyperlink node, resolved by
+// Collection.ResolveLinks or by the Resolver of the renderer
+func (r *RichTextRenderer) Resolve(node *Node) (interface{}, bool) {
+	switch target := node.Data["target"].(type) {
+	case *Entry:
+		return target, true
+	case *Asset:
+		return target, true
+	}
+
+	linkType, id, ok := node.Target()
+	if !ok || r.Resolver == nil {
+		return nil, false
+	}
+
+	return r.Resolver.ResolveLink(linkType, id)
+}
+
+// ResolveEntry returns the entry targeted by a node
+func (r *RichTextRenderer) ResolveEntry(node *Node) (*Entry, bool) {
+	target, _ := r.Resolve(node)
+	entry, ok := target.(*Entry)
+	return entry, ok
+}
+
+// ResolveAsset returns the asset targeted by a node
+func (r *RichTextRenderer) ResolveAsset(node *Node) (*Asset, bool) {
+	target, _ := r.Resolve(node)
+	asset, ok := target.(*Asset)
+	return asset, ok
+}
+
+// ResolveLink implements RichTextResolver on the includes of a collection
+func (includes Includes) ResolveLink(linkType, id string) (interface{}, bool) {
+	switch linkType {
+	case "Entry":
+		for _, entry := range includes.Entry {
+			if entry != nil && entry.Sys != nil && entry.Sys.ID == id {
+				return entry, true
+			}
+		}
+	case "Asset":
+		for _, asset := range includes.Asset {
+			if asset != nil && asset.Sys != nil && asset.Sys.ID == id {
+				return asset, true
+			}
+		}
+	}
+
+	return nil, false
+}
+
+// assetFile returns the url, title and content type of the file of an asset
+func (r *RichTextRenderer) assetFile(asset *Asset) (url, title, contentType string) {
+	if asset.Fields == nil {
+		return "", "", ""
+	}
+
+	locale := r.Locale
+	if locale == "" {
+		locale = asset.Locale
+	}
+
+	title = localizedString(asset.Fields.Title, locale)
+
+	file := asset.Fields.File[locale]
+	if file == nil && len(asset.Fields.File) == 1 {
+		for _, f := range asset.Fields.File {
+			file = f
+		}
+	}
+
+	if file == nil {
+		return "", title, ""
+	}
+
+	url = file.URL
+	if strings.HasPrefix(url, "//") {
+		url = "https:" + url
+	}
+
+	return url, title, file.ContentType
+}
+
+// localizedString returns the value of a locale, or the only value
+func localizedString(values map[string]string, locale string) string {
+	if value, ok := values[locale]; ok {
+		return value
+	}
+
+	if len(values) == 1 {
+		for _, value := range values {
+			return value
+		}
+	}
+
+	return ""
+}
+
+// NewHTMLRenderer returns a renderer of rich text to HTML. Embedded assets are
+// rendered as images or links when resolved, embedded entries are not rendered.
+func NewHTMLRenderer() *RichTextRenderer {
+	tag := func(name string) NodeRenderer {
+		return func(r *RichTextRenderer, node *Node) string {
+			return "<" + name + ">" + r.RenderNodes(node.Content) + "</" + name + ">"
+		}
+	}
+
+	wrap := func(name string) MarkRenderer {
+		return func(text string) string {
+			return "<" + name + ">" + text + "</" + name + ">"
+		}
+	}
+
+	children := func(r *RichTextRenderer, node *Node) string {
+		return r.RenderNodes(node.Content)
+	}
+
+	empty := func(r *RichTextRenderer, node *Node) string {
+		return ""
+	}
+
+	return &RichTextRenderer{
+		Nodes: map[string]NodeRenderer{},
+		Marks: map[string]MarkRenderer{},
+		defaultNodes: map[string]NodeRenderer{
+			NodeTypeDocument:        children,
+			NodeTypeParagraph:       tag("p"),
+			NodeTypeHeading1:        tag("h1"),
+			NodeTypeHeading2:        tag("h2"),
+			NodeTypeHeading3:        tag("h3"),
+			NodeTypeHeading4:        tag("h4"),
+			NodeTypeHeading5:        tag("h5"),
+			NodeTypeHeading6:        tag("h6"),
+			NodeTypeOrderedList:     tag("ol"),
+			NodeTypeUnorderedList:   tag("ul"),
+			NodeTypeListItem:        tag("li"),
+			NodeTypeBlockquote:      tag("blockquote"),
+			NodeTypeTable:           tag("table"),
+			NodeTypeTableRow:        tag("tr"),
+			NodeTypeTableCell:       tag("td"),
+			NodeTypeTableHeaderCell: tag("th"),
+			NodeTypeHr: func(r *RichTextRenderer, node *Node) string {
+				return "<hr/>"
+			},
+			NodeTypeHyperlink: func(r *RichTextRenderer, node *Node) string {
+				return fmt.Sprintf(`<a href="%s">%s</a>`, html.EscapeString(node.URI()), r.RenderNodes(node.Content))
+			},
+			NodeTypeEntryHyperlink: children,
+			NodeTypeAssetHyperlink: func(r *RichTextRenderer, node *Node) string {
+				asset, ok := r.ResolveAsset(node)
+				if !ok {
+					return r.RenderNodes(node.Content)
+				}
+
+				url, _, _ := r.assetFile(asset)
+				return fmt.Sprintf(`<a href="%s">%s</a>`, html.EscapeString(url), r.RenderNodes(node.Content))
+			},
+			NodeTypeEmbeddedAssetBlock: func(r *RichTextRenderer, node *Node) string {
+				asset, ok := r.ResolveAsset(node)
+				if !ok {
+					return ""
+				}
+
+				url, title, contentType := r.assetFile(asset)
+				if url == "" {
+					return ""
+				}
+
+				if strings.HasPrefix(contentType, "image/") {
+					return fmt.Sprintf(`<img src="%s" alt="%s"/>`, html.EscapeString(url), html.EscapeString(title))
+				}
+
+				return fmt.Sprintf(`<a href="%s">%s</a>`, html.EscapeString(url), html.EscapeString(title))
+			},
+			NodeTypeEmbeddedEntryBlock:  empty,
+			NodeTypeEmbeddedEntryInline: empty,
+			NodeTypeText: func(r *RichTextRenderer, node *Node) string {
+				text := strings.ReplaceAll(html.EscapeString(node.Value), "\n", "<br/>")
+				return r.RenderMarks(text, node.Marks)
+			},
+		},
+		defaultMarks: map[string]MarkRenderer{
+			MarkBold:          wrap("b"),
+			MarkItalic:        wrap("i"),
+			MarkUnderline:     wrap("u"),
+			MarkCode:          wrap("code"),
+			MarkSuperscript:   wrap("sup"),
+			MarkSubscript:     wrap("sub"),
+			MarkStrikethrough: wrap("s"),
+		},
+		fallback: children,
+	}
+}
+
+// markdownEscaper escapes the characters which are formatting in markdown
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	"`", "\\`",
+	"*", `\*`,
+	"_", `\_`,
+	"[", `\[`,
+	"]", `\]`,
+	"<", `\<`,
+)
+
+// NewMarkdownRenderer returns a renderer of rich text to Markdown. Embedded
+// assets are rendered as images or links when resolved, embedded entries are
+// not rendered. Marks without a markdown equivalent are rendered as HTML.
+func NewMarkdownRenderer() *RichTextRenderer {
+	block := func(prefix string) NodeRenderer {
+		return func(r *RichTextRenderer, node *Node) string {
+			return prefix + r.RenderNodes(node.Content) + "\n\n"
+		}
+	}
+
+	wrap := func(before, after string) MarkRenderer {
+		return func(text string) string {
+			return before + text + after
+		}
+	}
+
+	children := func(r *RichTextRenderer, node *Node) string {
+		return r.RenderNodes(node.Content)
+	}
+
+	empty := func(r *RichTextRenderer, node *Node) string {
+		return ""
+	}
+
+	link := func(r *RichTextRenderer, node *Node, url string) string {
+		return "[" + r.RenderNodes(node.Content) + "](" + markdownURL(url) + ")"
+	}
+
+	return &RichTextRenderer{
+		Nodes: map[string]NodeRenderer{},
+		Marks: map[string]MarkRenderer{},
+		defaultNodes: map[string]NodeRenderer{
+			NodeTypeDocument: func(r *RichTextRenderer, node *Node) string {
+				return strings.TrimRight(r.RenderNodes(node.Content), "\n") + "\n"
+			},
+			NodeTypeParagraph:     block(""),
+			NodeTypeHeading1:      block("# "),
+			NodeTypeHeading2:      block("## "),
+			NodeTypeHeading3:      block("### "),
+			NodeTypeHeading4:      block("#### "),
+			NodeTypeHeading5:      block("##### "),
+			NodeTypeHeading6:      block("###### "),
+			NodeTypeOrderedList:   markdownList(true),
+			NodeTypeUnorderedList: markdownList(false),
+			NodeTypeListItem:      markdownListItem,
+			NodeTypeBlockquote: func(r *RichTextRenderer, node *Node) string {
+				lines := strings.Split(strings.TrimSpace(r.RenderNodes(node.Content)), "\n")
+				for i, line := range lines {
+					lines[i] = strings.TrimRight("> "+line, " ")
+				}
+
+				return strings.Join(lines, "\n") + "\n\n"
+			},
+			NodeTypeHr:    block("---"),
+			NodeTypeTable: markdownTable,
+			NodeTypeHyperlink: func(r *RichTextRenderer, node *Node) string {
+				return link(r, node, node.URI())
+			},
+			NodeTypeEntryHyperlink: children,
+			NodeTypeAssetHyperlink: func(r *RichTextRenderer, node *Node) string {
+				asset, ok := r.ResolveAsset(node)
+				if !ok {
+					return r.RenderNodes(node.Content)
+				}
+
+				url, _, _ := r.assetFile(asset)
+				return link(r, node, url)
+			},
+			NodeTypeEmbeddedAssetBlock: func(r *RichTextRenderer, node *Node) string {
+				asset, ok := r.ResolveAsset(node)
+				if !ok {
+					return ""
+				}
+
+				url, title, contentType := r.assetFile(asset)
+				if url == "" {
+					return ""
+				}
+
+				if strings.HasPrefix(contentType, "image/") {
+					return "![" + markdownEscaper.Replace(title) + "](" + markdownURL(url) + ")\n\n"
+				}
+
+				return "[" + markdownEscaper.Replace(title) + "](" + markdownURL(url) + ")\n\n"
+			},
+			NodeTypeEmbeddedEntryBlock:  empty,
+			NodeTypeEmbeddedEntryInline: empty,
+			NodeTypeText: func(r *RichTextRenderer, node *Node) string {
+				if node.Value == "" {
+					return ""
+				}
+
+				text := node.Value
+				if !node.HasMark(MarkCode) {
+					text = markdownEscaper.Replace(text)
+				}
+
+				return r.RenderMarks(text, node.Marks)
+			},
+		},
+		defaultMarks: map[string]MarkRenderer{
+			MarkBold:          wrap("**", "**"),
+			MarkItalic:        wrap("_", "_"),
+			MarkUnderline:     wrap("<u>", "</u>"),
+			MarkCode:          wrap("`", "`"),
+			MarkSuperscript:   wrap("<sup>", "</sup>"),
+			MarkSubscript:     wrap("<sub>", "</sub>"),
+			MarkStrikethrough: wrap("~~", "~~"),
+		},
+		fallback: children,
+	}
+}
+
+// markdownList renders the items of a list, prefixed with their marker
+func markdownList(ordered bool) NodeRenderer {
+	return func(r *RichTextRenderer, node *Node) string {
+		var b strings.Builder
+		for i, item := range node.Content {
+			marker := "- "
+			if ordered {
+				marker = fmt.Sprintf("%d. ", i+1)
+			}
+
+			// continuation lines are indented under the text of the item
+			indent := strings.Repeat(" ", len(marker))
+			lines := strings.Split(strings.TrimRight(r.RenderNode(item), "\n"), "\n")
+			for j, line := range lines {
+				switch {
+				case j == 0:
+					b.WriteString(marker + line)
+				case line == "":
+				default:
+					b.WriteString(indent + line)
+				}
+
+				if line != "" || j == 0 {
+					b.WriteString("\n")
+				}
+			}
+		}
+
+		return b.String() + "\n"
+	}
+}
+
+// markdownListItem renders the blocks of a list item on consecutive lines
+func markdownListItem(r *RichTextRenderer, node *Node) string {
+	blocks := make([]string, 0, len(node.Content))
+	for _, child := range node.Content {
+		if block := strings.Trim(r.RenderNode(child), "\n"); block != "" {
+			blocks = append(blocks, block)
+		}
+	}
+
+	return strings.Join(blocks, "\n") + "\n"
+}
+
+// markdownTable renders a table, using its first row as the header
+func markdownTable(r *RichTextRenderer, node *Node) string {
+	var b strings.Builder
+	for i, row := range node.Content {
+		cells := make([]string, 0, len(row.Content))
+		for _, cell := range row.Content {
+			text := strings.Join(strings.Fields(r.RenderNodes(cell.Content)), " ")
+			cells = append(cells, strings.ReplaceAll(text, "|", `\|`))
+		}
+
+		b.WriteString("| " + strings.Join(cells, " | ") + " |\n")
+
+		if i == 0 {
+			separators := make([]string, len(cells))
+			for j := range separators {
+				separators[j] = "---"
+			}
+
+			b.WriteString("| " + strings.Join(separators, " | ") + " |\n")
+		}
+	}
+
+	return b.String() + "\n"
+}
+
+// markdownURL escapes the characters which end a markdown link destination
+func markdownURL(url string) string {
+	return strings.NewReplacer(" ", "%20", "(", "%28", ")", "%29").Replace(url)
+}
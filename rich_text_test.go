@@ -0,0 +1,177 @@
+package contentful
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func richTextDocument(t *testing.T) *Document {
+	var doc Document
+	if err := json.Unmarshal([]byte(readTestData("rich_text.json")), &doc); err != nil {
+		t.Fatal(err)
+	}
+
+	return &doc
+}
+
+func richTextAsset() *Asset {
+	return &Asset{
+		Sys: &Sys{ID: "nyancat-image"},
+		Fields: &AssetFields{
+			Title: map[string]string{"en-US": "Nyan Cat"},
+			File: map[string]*File{
+				"en-US": {URL: "//images.ctfassets.net/nyancat.png", ContentType: "image/png"},
+			},
+		},
+	}
+}
+
+func TestRichTextJSONRoundTrip(t *testing.T) {
+	assertions := assert.New(t)
+
+	doc := richTextDocument(t)
+	assertions.Len(doc.Content, 9)
+	assertions.Equal(NodeTypeHeading1, doc.Content[0].NodeType)
+	assertions.True(doc.Content[1].Content[1].HasMark(MarkBold))
+	assertions.Equal("https://example.com", doc.Content[1].Content[3].URI())
+
+	linkType, id, ok := doc.Content[5].Target()
+	assertions.True(ok)
+	assertions.Equal("Asset", linkType)
+	assertions.Equal("nyancat-image", id)
+
+	byteArray, err := json.Marshal(doc)
+	assertions.Nil(err)
+	assertions.JSONEq(readTestData("rich_text.json"), string(byteArray))
+}
+
+func TestRichTextUnmarshalNotDocument(t *testing.T) {
+	assertions := assert.New(t)
+
+	var doc Document
+	err := json.Unmarshal([]byte(`{"nodeType": "paragraph", "data": {}, "content": []}`), &doc)
+	assertions.NotNil(err)
+}
+
+func TestParseRichTextKeepsResolvedLinks(t *testing.T) {
+	assertions := assert.New(t)
+
+	var value map[string]interface{}
+	assertions.Nil(json.Unmarshal([]byte(readTestData("rich_text.json")), &value))
+
+	// resolve the embedded asset like Collection.ResolveLinks does
+	asset := richTextAsset()
+	embedded := value["content"].([]interface{})[5].(map[string]interface{})
+	embedded["data"].(map[string]interface{})["target"] = asset
+
+	doc, err := ParseRichText(value)
+	assertions.Nil(err)
+	assertions.Same(asset, doc.Content[5].Data["target"])
+
+	_, err = ParseRichText(map[string]interface{}{"nodeType": "paragraph"})
+	assertions.NotNil(err)
+}
+
+func TestHTMLRenderer(t *testing.T) {
+	assertions := assert.New(t)
+
+	renderer := NewHTMLRenderer()
+	renderer.Resolver = Includes{Asset: []*Asset{richTextAsset()}}
+
+	expected := "<h1>Nyan Cat</h1>" +
+		`<p>Hello <b>world</b> and <a href="https://example.com">a link</a>.</p>` +
+		"<ul><li><p>first</p></li><li><p>second</p><ol><li><p>nested</p></li></ol></li></ul>" +
+		"<blockquote><p>Meow</p></blockquote>" +
+		"<hr/>" +
+		`<img src="https://images.ctfassets.net/nyancat.png" alt="Nyan Cat"/>` +
+		"<table><tr><th><p>Name</p></th><th><p>Color</p></th></tr><tr><td><p>Nyan</p></td><td><p>rainbow</p></td></tr></table>" +
+		"<p><code>snake_case</code> is &lt;code&gt;</p>"
+
+	assertions.Equal(expected, renderer.Render(richTextDocument(t)))
+}
+
+func TestMarkdownRenderer(t *testing.T) {
+	assertions := assert.New(t)
+
+	renderer := NewMarkdownRenderer()
+	renderer.Resolver = Includes{Asset: []*Asset{richTextAsset()}}
+
+	expected := strings.Join([]string{
+		"# Nyan Cat",
+		"",
+		"Hello **world** and [a link](https://example.com).",
+		"",
+		"- first",
+		"- second",
+		"  1. nested",
+		"",
+		"> Meow",
+		"",
+		"---",
+		"",
+		"![Nyan Cat](https://images.ctfassets.net/nyancat.png)",
+		"",
+		"| Name | Color |",
+		"| --- | --- |",
+		"| Nyan | rainbow |",
+		"",
+		"`snake_case` is \\<code>",
+		"",
+	}, "\n")
+
+	assertions.Equal(expected, renderer.Render(richTextDocument(t)))
+}
+
+func TestRichTextRendererOverrides(t *testing.T) {
+	assertions := assert.New(t)
+
+	renderer := NewHTMLRenderer()
+	renderer.Resolver = RichTextResolverFunc(func(linkType, id string) (interface{}, bool) {
+		if linkType == "Entry" && id == "happycat" {
+			return &Entry{Sys: &Sys{ID: id}, Fields: map[string]interface{}{"name": "Happy Cat"}}, true
+		}
+
+		return nil, false
+	})
+	renderer.Nodes[NodeTypeEmbeddedEntryBlock] = func(r *RichTextRenderer, node *Node) string {
+		entry, ok := r.ResolveEntry(node)
+		if !ok {
+			return ""
+		}
+
+		return `<div class="cat">` + entry.Fields["name"].(string) + "</div>"
+	}
+	renderer.Marks[MarkBold] = func(text string) string {
+		return "<strong>" + text + "</strong>"
+	}
+
+	html := renderer.Render(richTextDocument(t))
+	assertions.Contains(html, `<div class="cat">Happy Cat</div>`)
+	assertions.Contains(html, "<strong>world</strong>")
+
+	// the asset can not be resolved
+	assertions.NotContains(html, "<img")
+}
+
+func TestEntryDecodeRichText(t *testing.T) {
+	assertions := assert.New(t)
+
+	var body map[string]interface{}
+	assertions.Nil(json.Unmarshal([]byte(readTestData("rich_text.json")), &body))
+
+	entry := &Entry{
+		Sys:    &Sys{ID: "nyancat"},
+		Fields: map[string]interface{}{"body": map[string]interface{}{"en-US": body}},
+	}
+
+	var cat struct {
+		Body *Document `contentful:"body"`
+	}
+
+	assertions.Nil(entry.DecodeLocale(&cat, "en-US"))
+	assertions.NotNil(cat.Body)
+	assertions.Len(cat.Body.Content, 9)
+}
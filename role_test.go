@@ -1,6 +1,7 @@
 package contentful
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -30,9 +31,9 @@ func TestRolesService_List(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	collection, err := cma.Roles.List(spaceID).Next()
+	collection, err := cma.Roles.List(spaceID).Next(context.Background())
 	assertions.Nil(err)
 	role := collection.ToRole()
 	assertions.Equal(2, len(role))
@@ -59,9 +60,9 @@ func TestRolesService_Get(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	role, err := cma.Roles.Get(spaceID, "0xvkNW6WdQ8JkWlWZ8BC4x")
+	role, err := cma.Roles.Get(context.Background(), spaceID, "0xvkNW6WdQ8JkWlWZ8BC4x")
 	assertions.Nil(err)
 	assertions.Equal("Some role", role.Name)
 }
@@ -86,10 +87,11 @@ func TestRolesService_Get_2(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	_, err = cma.Roles.Get(spaceID, "0xvkNW6WdQ8JkWlWZ8BC4x")
-	assertions.Nil(err)
+	role, err := cma.Roles.Get(context.Background(), spaceID, "0xvkNW6WdQ8JkWlWZ8BC4x")
+	assertions.NotNil(err)
+	assertions.Nil(role)
 }
 
 func TestRolesService_Upsert_Create(t *testing.T) {
@@ -121,7 +123,7 @@ func TestRolesService_Upsert_Create(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	role := &Role{
 		Name:        "Author",
@@ -157,7 +159,7 @@ func TestRolesService_Upsert_Create(t *testing.T) {
 		},
 	}
 
-	err = cma.Roles.Upsert(spaceID, role)
+	err = cma.Roles.Upsert(context.Background(), spaceID, role)
 	assertions.Nil(err)
 }
 
@@ -188,14 +190,14 @@ func TestRolesService_Upsert_Update(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	role, err := roleFromTestData("role_1.json")
 	assertions.Nil(err)
 
 	role.Description = "Edited text"
 
-	err = cma.Roles.Upsert(spaceID, role)
+	err = cma.Roles.Upsert(context.Background(), spaceID, role)
 	assertions.Nil(err)
 }
 
@@ -217,13 +219,13 @@ func TestRolesServiceDelete(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	// test role
 	role, err := roleFromTestData("role_1.json")
 	assertions.Nil(err)
 
 	// delete role
-	err = cma.Roles.Delete(spaceID, role.Sys.ID)
+	err = cma.Roles.Delete(context.Background(), spaceID, role.Sys.ID)
 	assertions.Nil(err)
 }
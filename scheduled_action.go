@@ -2,10 +2,13 @@ package contentful
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strconv"
+	"time"
 )
 
 // ScheduledActionsService service
@@ -30,6 +33,9 @@ type EnvironmentLink struct {
 	Sys Sys `json:"sys"`
 }
 
+// scheduledActionDatetimeFormat is the format of the datetime of a scheduled action
+const scheduledActionDatetimeFormat = "2006-01-02T15:04:05.000Z07:00"
+
 // GetVersion returns entity version
 func (scheduledAction *ScheduledAction) GetVersion() int {
 	version := 1
@@ -42,26 +48,30 @@ func (scheduledAction *ScheduledAction) GetVersion() int {
 
 // List returns scheduled actions collection
 func (service *ScheduledActionsService) List(spaceID, entryID string) *Collection {
-	path := fmt.Sprintf("/spaces/%s/scheduled_actions?entity.sys.id=%s&environment.sys.id=%s", spaceID, entryID, service.c.Environment)
+	path := fmt.Sprintf("/spaces/%s/scheduled_actions", spaceID)
 
-	req, err := service.c.newRequest(http.MethodGet, path, nil, nil)
+	req, err := service.c.newRequest(context.Background(), http.MethodGet, path, nil, nil)
 	if err != nil {
 		return &Collection{}
 	}
 
+	// the collection builds the query of each page from its own query
 	col := NewCollection(&CollectionOptions{})
+	col.Query.Equal("entity.sys.id", entryID)
 	col.c = service.c
 	col.req = req
+	col.filterEnvironment = true
 
 	return col
 }
 
 // Delete the scheduled action
-func (service *ScheduledActionsService) Delete(spaceID, entryID, scheduledActionID string) error {
-	path := fmt.Sprintf("/spaces/%s/scheduled_actions/%s?entity.sys.id=%s&environment.sys.id=%s", spaceID, scheduledActionID, entryID, service.c.Environment)
+func (service *ScheduledActionsService) Delete(ctx context.Context, spaceID, entryID, scheduledActionID string) error {
+	path := fmt.Sprintf("/spaces/%s/scheduled_actions/%s", spaceID, scheduledActionID)
+	query := scheduledActionQuery(entryID, service.c.environment(ctx))
 	method := "DELETE"
 
-	req, err := service.c.newRequest(method, path, nil, nil)
+	req, err := service.c.newRequest(ctx, method, path, query, nil)
 	if err != nil {
 		return err
 	}
@@ -70,15 +80,16 @@ func (service *ScheduledActionsService) Delete(spaceID, entryID, scheduledAction
 }
 
 // Create creates a new scheduled actions
-func (service *ScheduledActionsService) Create(spaceID, entryID string, scheduledAction *ScheduledAction) error {
+func (service *ScheduledActionsService) Create(ctx context.Context, spaceID, entryID string, scheduledAction *ScheduledAction) error {
 	bytesArray, err := json.Marshal(scheduledAction)
 	if err != nil {
 		return err
 	}
-	path := fmt.Sprintf("/spaces/%s/scheduled_actions?entity.sys.id=%s&environment.sys.id=%s", spaceID, entryID, service.c.Environment)
+	path := fmt.Sprintf("/spaces/%s/scheduled_actions", spaceID)
+	query := scheduledActionQuery(entryID, service.c.environment(ctx))
 	method := "POST"
 
-	req, err := service.c.newRequest(method, path, nil, bytes.NewReader(bytesArray))
+	req, err := service.c.newRequest(ctx, method, path, query, bytes.NewReader(bytesArray))
 	if err != nil {
 		return err
 	}
@@ -87,3 +98,36 @@ func (service *ScheduledActionsService) Create(spaceID, entryID string, schedule
 
 	return service.c.do(req, scheduledAction)
 }
+
+// ScheduleRelease schedules a release action, ReleaseActionPublish or
+// ReleaseActionUnpublish, of the given release in the client environment
+func (service *ScheduledActionsService) ScheduleRelease(ctx context.Context, spaceID string, release *Release, action string, scheduledFor time.Time) (*ScheduledAction, error) {
+	scheduledAction := &ScheduledAction{
+		Entity: ReleaseLink(release),
+		Environment: EnvironmentLink{
+			Sys: Sys{
+				Type:     "Link",
+				LinkType: "Environment",
+				ID:       service.c.environment(ctx),
+			},
+		},
+		ScheduledFor: map[string]string{
+			"datetime": scheduledFor.UTC().Format(scheduledActionDatetimeFormat),
+		},
+		Action: action,
+	}
+
+	if err := service.Create(ctx, spaceID, release.Sys.ID, scheduledAction); err != nil {
+		return nil, err
+	}
+
+	return scheduledAction, nil
+}
+
+// scheduledActionQuery filters scheduled actions by entity and environment
+func scheduledActionQuery(entityID, environment string) url.Values {
+	return url.Values{
+		"entity.sys.id":      []string{entityID},
+		"environment.sys.id": []string{environment},
+	}
+}
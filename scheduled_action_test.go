@@ -1,6 +1,7 @@
 package contentful
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -16,7 +17,9 @@ func TestScheduledActionsService_List(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "GET")
-		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/scheduled_actions?entity.sys.id=5KsDBWseXY6QegucYAoacS&environment.sys.id=master")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/scheduled_actions")
+		assertions.Equal("5KsDBWseXY6QegucYAoacS", r.URL.Query().Get("entity.sys.id"))
+		assertions.Equal("master", r.URL.Query().Get("environment.sys.id"))
 
 		checkHeaders(r, assertions)
 
@@ -30,9 +33,9 @@ func TestScheduledActionsService_List(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	collection, err := cma.ScheduledActions.List(spaceID, "5KsDBWseXY6QegucYAoacS").Next()
+	collection, err := cma.ScheduledActions.List(spaceID, "5KsDBWseXY6QegucYAoacS").Next(context.Background())
 	assertions.Nil(err)
 	scheduledActions := collection.ToScheduledAction()
 	assertions.Equal(1, len(scheduledActions))
@@ -45,7 +48,9 @@ func TestScheduledActionsService_Delete(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "DELETE")
-		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/scheduled_actions/3A13SXSDwO8c46NrjigFYT?entity.sys.id=5KsDBWseXY6QegucYAoacS&environment.sys.id=master")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/scheduled_actions/3A13SXSDwO8c46NrjigFYT")
+		assertions.Equal("5KsDBWseXY6QegucYAoacS", r.URL.Query().Get("entity.sys.id"))
+		assertions.Equal("master", r.URL.Query().Get("environment.sys.id"))
 		checkHeaders(r, assertions)
 
 		w.WriteHeader(200)
@@ -57,12 +62,12 @@ func TestScheduledActionsService_Delete(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	scheduledAction, err := scheduledActionFromTestFile("scheduled_action_canceled.json")
 	assertions.Nil(err)
 
-	err = cma.ScheduledActions.Delete(spaceID, "5KsDBWseXY6QegucYAoacS", scheduledAction.Sys.ID)
+	err = cma.ScheduledActions.Delete(context.Background(), spaceID, "5KsDBWseXY6QegucYAoacS", scheduledAction.Sys.ID)
 	assertions.Nil(err)
 	assertions.Equal("3A13SXSDwO8c46NrjigFYT", scheduledAction.Sys.ID)
 }
@@ -72,7 +77,9 @@ func TestScheduledActionsService_Create(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assertions.Equal(r.Method, "POST")
-		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/scheduled_actions?entity.sys.id=5KsDBWseXY6QegucYAoacS&environment.sys.id=master")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/scheduled_actions")
+		assertions.Equal("5KsDBWseXY6QegucYAoacS", r.URL.Query().Get("entity.sys.id"))
+		assertions.Equal("master", r.URL.Query().Get("environment.sys.id"))
 		checkHeaders(r, assertions)
 
 		var payload map[string]interface{}
@@ -90,7 +97,7 @@ func TestScheduledActionsService_Create(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	scheduledAction := &ScheduledAction{
 		Sys: &Sys{
@@ -116,7 +123,7 @@ func TestScheduledActionsService_Create(t *testing.T) {
 		Action: "publish",
 	}
 
-	err := cma.ScheduledActions.Create(spaceID, "5KsDBWseXY6QegucYAoacS", scheduledAction)
+	err := cma.ScheduledActions.Create(context.Background(), spaceID, "5KsDBWseXY6QegucYAoacS", scheduledAction)
 	assertions.Nil(err)
 
 	assertions.Equal("publish", scheduledAction.Action)
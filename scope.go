@@ -0,0 +1,753 @@
+package contentful
+
+import (
+	"context"
+	"time"
+)
+
+// SpaceScope is a handle on a space, returned by Client.Space. It is
+// immutable and safe for concurrent use, and shares the http client, rate
+// limiter and settings of its client. The services of a scope take no space
+// id.
+//
+//	staging := cma.Space(spaceID).Environment("staging")
+//	entries := staging.Entries.List()
+type SpaceScope struct {
+	client  *Client
+	spaceID string
+
+	Environments       *ScopedEnvironmentsService
+	EnvironmentAliases *ScopedEnvironmentAliasesService
+	Roles              *ScopedRolesService
+	Memberships        *ScopedMembershipsService
+	APIKeys            *ScopedAPIKeyService
+	Webhooks           *ScopedWebhooksService
+	WebhookCalls       *ScopedWebhookCallsService
+}
+
+// EnvironmentScope is a handle on an environment of a space, returned by
+// SpaceScope.Environment. Like SpaceScope, it is immutable and shares the
+// http client, rate limiter and settings of its client, including the ones
+// set later, whatever the environment of the client is. Its environment also
+// wins over the one of a context set with WithEnvironment.
+type EnvironmentScope struct {
+	client        *Client
+	spaceID       string
+	environmentID string
+
+	Entries          *ScopedEntriesService
+	Assets           *ScopedAssetsService
+	ContentTypes     *ScopedContentTypesService
+	Locales          *ScopedLocalesService
+	EditorInterfaces *ScopedEditorInterfacesService
+	Extensions       *ScopedExtensionsService
+	EntryTasks       *ScopedEntryTasksService
+	Snapshots        *ScopedSnapshotsService
+	AppInstallations *ScopedAppInstallationsService
+	ScheduledActions *ScopedScheduledActionsService
+	Sync             *ScopedSyncService
+	BulkActions      *ScopedBulkActionsService
+	Releases         *ScopedReleasesService
+}
+
+// Space returns a handle on a space
+func (c *Client) Space(spaceID string) *SpaceScope {
+	common := &service{c: c}
+
+	return &SpaceScope{
+		client:             c,
+		spaceID:            spaceID,
+		Environments:       &ScopedEnvironmentsService{(*EnvironmentsService)(common), spaceID},
+		EnvironmentAliases: &ScopedEnvironmentAliasesService{(*EnvironmentAliasesService)(common), spaceID},
+		Roles:              &ScopedRolesService{(*RolesService)(common), spaceID},
+		Memberships:        &ScopedMembershipsService{(*MembershipsService)(common), spaceID},
+		APIKeys:            &ScopedAPIKeyService{(*APIKeyService)(common), spaceID},
+		Webhooks:           &ScopedWebhooksService{(*WebhooksService)(common), spaceID},
+		WebhookCalls:       &ScopedWebhookCallsService{(*WebhookCallsService)(common), spaceID},
+	}
+}
+
+// ID returns the id of the space
+func (s *SpaceScope) ID() string {
+	return s.spaceID
+}
+
+// DiffEnvironments returns the changes of the content model from an environment of the space to another one
+func (s *SpaceScope) DiffEnvironments(ctx context.Context, fromEnvironment, toEnvironment string) (*ContentModelDiff, error) {
+	return DiffEnvironments(ctx, s.client, s.spaceID, fromEnvironment, toEnvironment)
+}
+
+// Environment returns a handle on an environment of the space
+func (s *SpaceScope) Environment(environmentID string) *EnvironmentScope {
+	common := &service{c: s.client.withEnvironment(environmentID)}
+	spaceID := s.spaceID
+
+	return &EnvironmentScope{
+		client:           common.c,
+		spaceID:          spaceID,
+		environmentID:    environmentID,
+		Entries:          &ScopedEntriesService{(*EntriesService)(common), spaceID},
+		Assets:           &ScopedAssetsService{(*AssetsService)(common), spaceID},
+		ContentTypes:     &ScopedContentTypesService{(*ContentTypesService)(common), spaceID},
+		Locales:          &ScopedLocalesService{(*LocalesService)(common), spaceID},
+		EditorInterfaces: &ScopedEditorInterfacesService{(*EditorInterfacesService)(common), spaceID},
+		Extensions:       &ScopedExtensionsService{(*ExtensionsService)(common), spaceID},
+		EntryTasks:       &ScopedEntryTasksService{(*EntryTasksService)(common), spaceID},
+		Snapshots:        &ScopedSnapshotsService{(*SnapshotsService)(common), spaceID},
+		AppInstallations: &ScopedAppInstallationsService{(*AppInstallationsService)(common), spaceID},
+		ScheduledActions: &ScopedScheduledActionsService{(*ScheduledActionsService)(common), spaceID},
+		Sync:             &ScopedSyncService{(*SyncService)(common), spaceID},
+		BulkActions:      &ScopedBulkActionsService{(*BulkActionsService)(common), spaceID},
+		Releases:         &ScopedReleasesService{(*ReleasesService)(common), spaceID},
+	}
+}
+
+// SpaceID returns the id of the space of the environment
+func (e *EnvironmentScope) SpaceID() string {
+	return e.spaceID
+}
+
+// ID returns the id of the environment
+func (e *EnvironmentScope) ID() string {
+	return e.environmentID
+}
+
+// Exporter returns an exporter of the environment
+func (e *EnvironmentScope) Exporter(options *ExportOptions) *Exporter {
+	return NewExporter(e.client, e.spaceID, options)
+}
+
+// Importer returns an importer of entries and assets into the environment
+func (e *EnvironmentScope) Importer(options *ImportOptions) *Importer {
+	return NewImporter(e.client, e.spaceID, options)
+}
+
+// SpaceImporter returns an importer of contentful-export documents into the environment
+func (e *EnvironmentScope) SpaceImporter(options *SpaceImportOptions) *SpaceImporter {
+	return NewSpaceImporter(e.client, e.spaceID, options)
+}
+
+// MigrationRunner returns a runner of named migrations against the environment
+func (e *EnvironmentScope) MigrationRunner(options *MigrationRunnerOptions) *MigrationRunner {
+	return NewMigrationRunner(e.client, e.spaceID, options)
+}
+
+// ApplyPlan applies a migration plan to the environment
+func (e *EnvironmentScope) ApplyPlan(ctx context.Context, plan *MigrationPlan) error {
+	return plan.Apply(ctx, e.client, e.spaceID)
+}
+
+// Migrate runs a migration against the environment
+func (e *EnvironmentScope) Migrate(ctx context.Context, m *Migration) (*MigrationLog, error) {
+	return m.Run(ctx, e.client, e.spaceID)
+}
+
+// ScopedEnvironmentsService is the EnvironmentsService of a space
+type ScopedEnvironmentsService struct {
+	service *EnvironmentsService
+	spaceID string
+}
+
+// List returns an environments collection
+func (s *ScopedEnvironmentsService) List() *Collection {
+	return s.service.List(s.spaceID)
+}
+
+// Get returns a single environment
+func (s *ScopedEnvironmentsService) Get(ctx context.Context, environmentID string) (*Environment, error) {
+	return s.service.Get(ctx, s.spaceID, environmentID)
+}
+
+// Upsert updates or creates a new environment
+func (s *ScopedEnvironmentsService) Upsert(ctx context.Context, e *Environment) error {
+	return s.service.Upsert(ctx, s.spaceID, e)
+}
+
+// Delete the environment
+func (s *ScopedEnvironmentsService) Delete(ctx context.Context, e *Environment) error {
+	return s.service.Delete(ctx, s.spaceID, e)
+}
+
+// WaitReady waits until the environment is ready
+func (s *ScopedEnvironmentsService) WaitReady(ctx context.Context, environmentID string, interval time.Duration) (*Environment, error) {
+	return s.service.WaitReady(ctx, s.spaceID, environmentID, interval)
+}
+
+// ScopedEnvironmentAliasesService is the EnvironmentAliasesService of a space
+type ScopedEnvironmentAliasesService struct {
+	service *EnvironmentAliasesService
+	spaceID string
+}
+
+// List returns an environment aliases collection
+func (s *ScopedEnvironmentAliasesService) List() *Collection {
+	return s.service.List(s.spaceID)
+}
+
+// Get returns a single environment alias
+func (s *ScopedEnvironmentAliasesService) Get(ctx context.Context, environmentAliasID string) (*EnvironmentAlias, error) {
+	return s.service.Get(ctx, s.spaceID, environmentAliasID)
+}
+
+// Update updates an environment alias
+func (s *ScopedEnvironmentAliasesService) Update(ctx context.Context, ea *EnvironmentAlias) error {
+	return s.service.Update(ctx, s.spaceID, ea)
+}
+
+// ScopedRolesService is the RolesService of a space
+type ScopedRolesService struct {
+	service *RolesService
+	spaceID string
+}
+
+// List returns a roles collection
+func (s *ScopedRolesService) List() *Collection {
+	return s.service.List(s.spaceID)
+}
+
+// Get returns a single role
+func (s *ScopedRolesService) Get(ctx context.Context, roleID string) (*Role, error) {
+	return s.service.Get(ctx, s.spaceID, roleID)
+}
+
+// Upsert updates or creates a new role
+func (s *ScopedRolesService) Upsert(ctx context.Context, r *Role) error {
+	return s.service.Upsert(ctx, s.spaceID, r)
+}
+
+// Delete the role
+func (s *ScopedRolesService) Delete(ctx context.Context, roleID string) error {
+	return s.service.Delete(ctx, s.spaceID, roleID)
+}
+
+// ScopedMembershipsService is the MembershipsService of a space
+type ScopedMembershipsService struct {
+	service *MembershipsService
+	spaceID string
+}
+
+// List returns a memberships collection
+func (s *ScopedMembershipsService) List() *Collection {
+	return s.service.List(s.spaceID)
+}
+
+// Get returns a single membership
+func (s *ScopedMembershipsService) Get(ctx context.Context, membershipID string) (*Membership, error) {
+	return s.service.Get(ctx, s.spaceID, membershipID)
+}
+
+// Upsert updates or creates a new membership
+func (s *ScopedMembershipsService) Upsert(ctx context.Context, m *Membership) error {
+	return s.service.Upsert(ctx, s.spaceID, m)
+}
+
+// Delete the membership
+func (s *ScopedMembershipsService) Delete(ctx context.Context, membershipID string) error {
+	return s.service.Delete(ctx, s.spaceID, membershipID)
+}
+
+// ScopedAPIKeyService is the APIKeyService of a space
+type ScopedAPIKeyService struct {
+	service *APIKeyService
+	spaceID string
+}
+
+// List returns an api keys collection
+func (s *ScopedAPIKeyService) List() *Collection {
+	return s.service.List(s.spaceID)
+}
+
+// Get returns a single api key
+func (s *ScopedAPIKeyService) Get(ctx context.Context, apiKeyID string) (*APIKey, error) {
+	return s.service.Get(ctx, s.spaceID, apiKeyID)
+}
+
+// Upsert updates or creates a new api key
+func (s *ScopedAPIKeyService) Upsert(ctx context.Context, apiKey *APIKey) error {
+	return s.service.Upsert(ctx, s.spaceID, apiKey)
+}
+
+// Delete the api key
+func (s *ScopedAPIKeyService) Delete(ctx context.Context, apiKey *APIKey) error {
+	return s.service.Delete(ctx, s.spaceID, apiKey)
+}
+
+// ScopedWebhooksService is the WebhooksService of a space
+type ScopedWebhooksService struct {
+	service *WebhooksService
+	spaceID string
+}
+
+// List returns a webhooks collection
+func (s *ScopedWebhooksService) List() *Collection {
+	return s.service.List(s.spaceID)
+}
+
+// Get returns a single webhook
+func (s *ScopedWebhooksService) Get(ctx context.Context, webhookID string) (*Webhook, error) {
+	return s.service.Get(ctx, s.spaceID, webhookID)
+}
+
+// Upsert updates or creates a new webhook
+func (s *ScopedWebhooksService) Upsert(ctx context.Context, webhook *Webhook) error {
+	return s.service.Upsert(ctx, s.spaceID, webhook)
+}
+
+// Delete the webhook
+func (s *ScopedWebhooksService) Delete(ctx context.Context, webhook *Webhook) error {
+	return s.service.Delete(ctx, s.spaceID, webhook)
+}
+
+// ScopedWebhookCallsService is the WebhookCallsService of a space
+type ScopedWebhookCallsService struct {
+	service *WebhookCallsService
+	spaceID string
+}
+
+// List returns a webhook calls collection
+func (s *ScopedWebhookCallsService) List(webhookID string) *Collection {
+	return s.service.List(s.spaceID, webhookID)
+}
+
+// Get returns a single webhook call
+func (s *ScopedWebhookCallsService) Get(ctx context.Context, webhookID, callID string) (*WebhookCall, error) {
+	return s.service.Get(ctx, s.spaceID, webhookID, callID)
+}
+
+// Health returns the health of a webhook
+func (s *ScopedWebhookCallsService) Health(ctx context.Context, webhookID string) (*WebhookHealth, error) {
+	return s.service.Health(ctx, s.spaceID, webhookID)
+}
+
+// ScopedEntriesService is the EntriesService of an environment
+type ScopedEntriesService struct {
+	service *EntriesService
+	spaceID string
+}
+
+// List returns entries collection
+func (s *ScopedEntriesService) List() *Collection {
+	return s.service.List(s.spaceID)
+}
+
+// ListWithContentType returns entries collection of a content type
+func (s *ScopedEntriesService) ListWithContentType(contentType string) *Collection {
+	return s.service.ListWithContentType(s.spaceID, contentType)
+}
+
+// Get returns a single entry
+func (s *ScopedEntriesService) Get(ctx context.Context, entryID string) (*Entry, error) {
+	return s.service.Get(ctx, s.spaceID, entryID)
+}
+
+// Upsert updates or creates a new entry
+func (s *ScopedEntriesService) Upsert(ctx context.Context, contentTypeID string, e *Entry) error {
+	return s.service.Upsert(ctx, s.spaceID, contentTypeID, e)
+}
+
+// Delete the entry
+func (s *ScopedEntriesService) Delete(ctx context.Context, entryID string) error {
+	return s.service.Delete(ctx, s.spaceID, entryID)
+}
+
+// Publish the entry
+func (s *ScopedEntriesService) Publish(ctx context.Context, entry *Entry) error {
+	return s.service.Publish(ctx, s.spaceID, entry)
+}
+
+// Unpublish the entry
+func (s *ScopedEntriesService) Unpublish(ctx context.Context, entry *Entry) error {
+	return s.service.Unpublish(ctx, s.spaceID, entry)
+}
+
+// Archive the entry
+func (s *ScopedEntriesService) Archive(ctx context.Context, entry *Entry) error {
+	return s.service.Archive(ctx, s.spaceID, entry)
+}
+
+// Unarchive the entry
+func (s *ScopedEntriesService) Unarchive(ctx context.Context, entry *Entry) error {
+	return s.service.Unarchive(ctx, s.spaceID, entry)
+}
+
+// ScopedAssetsService is the AssetsService of an environment
+type ScopedAssetsService struct {
+	service *AssetsService
+	spaceID string
+}
+
+// List returns asset collection
+func (s *ScopedAssetsService) List() *Collection {
+	return s.service.List(s.spaceID)
+}
+
+// ListPublished returns the published asset collection
+func (s *ScopedAssetsService) ListPublished() *Collection {
+	return s.service.ListPublished(s.spaceID)
+}
+
+// Get returns a single asset
+func (s *ScopedAssetsService) Get(ctx context.Context, assetID string) (*Asset, error) {
+	return s.service.Get(ctx, s.spaceID, assetID)
+}
+
+// Upsert updates or creates a new asset
+func (s *ScopedAssetsService) Upsert(ctx context.Context, asset *Asset) error {
+	return s.service.Upsert(ctx, s.spaceID, asset)
+}
+
+// Delete the asset
+func (s *ScopedAssetsService) Delete(ctx context.Context, asset *Asset) error {
+	return s.service.Delete(ctx, s.spaceID, asset)
+}
+
+// Process the asset
+func (s *ScopedAssetsService) Process(ctx context.Context, asset *Asset) error {
+	return s.service.Process(ctx, s.spaceID, asset)
+}
+
+// Publish the asset
+func (s *ScopedAssetsService) Publish(ctx context.Context, asset *Asset) error {
+	return s.service.Publish(ctx, s.spaceID, asset)
+}
+
+// Unpublish the asset
+func (s *ScopedAssetsService) Unpublish(ctx context.Context, asset *Asset) error {
+	return s.service.Unpublish(ctx, s.spaceID, asset)
+}
+
+// Archive the asset
+func (s *ScopedAssetsService) Archive(ctx context.Context, asset *Asset) error {
+	return s.service.Archive(ctx, s.spaceID, asset)
+}
+
+// Unarchive the asset
+func (s *ScopedAssetsService) Unarchive(ctx context.Context, asset *Asset) error {
+	return s.service.Unarchive(ctx, s.spaceID, asset)
+}
+
+// ScopedContentTypesService is the ContentTypesService of an environment
+type ScopedContentTypesService struct {
+	service *ContentTypesService
+	spaceID string
+}
+
+// List returns a content types collection
+func (s *ScopedContentTypesService) List() *Collection {
+	return s.service.List(s.spaceID)
+}
+
+// ListActivated returns the activated content types collection
+func (s *ScopedContentTypesService) ListActivated() *Collection {
+	return s.service.ListActivated(s.spaceID)
+}
+
+// Get returns a single content type
+func (s *ScopedContentTypesService) Get(ctx context.Context, contentTypeID string) (*ContentType, error) {
+	return s.service.Get(ctx, s.spaceID, contentTypeID)
+}
+
+// Upsert updates or creates a new content type
+func (s *ScopedContentTypesService) Upsert(ctx context.Context, ct *ContentType) error {
+	return s.service.Upsert(ctx, s.spaceID, ct)
+}
+
+// Delete the content type
+func (s *ScopedContentTypesService) Delete(ctx context.Context, ct *ContentType) error {
+	return s.service.Delete(ctx, s.spaceID, ct)
+}
+
+// Activate the content type, a.k.a publish
+func (s *ScopedContentTypesService) Activate(ctx context.Context, ct *ContentType) error {
+	return s.service.Activate(ctx, s.spaceID, ct)
+}
+
+// Deactivate the content type, a.k.a unpublish
+func (s *ScopedContentTypesService) Deactivate(ctx context.Context, ct *ContentType) error {
+	return s.service.Deactivate(ctx, s.spaceID, ct)
+}
+
+// ScopedLocalesService is the LocalesService of an environment
+type ScopedLocalesService struct {
+	service *LocalesService
+	spaceID string
+}
+
+// List returns a locales collection
+func (s *ScopedLocalesService) List() *Collection {
+	return s.service.List(s.spaceID)
+}
+
+// Get returns a single locale
+func (s *ScopedLocalesService) Get(ctx context.Context, localeID string) (*Locale, error) {
+	return s.service.Get(ctx, s.spaceID, localeID)
+}
+
+// Upsert updates or creates a new locale
+func (s *ScopedLocalesService) Upsert(ctx context.Context, locale *Locale) error {
+	return s.service.Upsert(ctx, s.spaceID, locale)
+}
+
+// Delete the locale
+func (s *ScopedLocalesService) Delete(ctx context.Context, locale *Locale) error {
+	return s.service.Delete(ctx, s.spaceID, locale)
+}
+
+// Fallbacks returns the fallbacks of the locales of the environment
+func (s *ScopedLocalesService) Fallbacks(ctx context.Context) (LocaleFallbacks, error) {
+	return s.service.Fallbacks(ctx, s.spaceID)
+}
+
+// ScopedEditorInterfacesService is the EditorInterfacesService of an environment
+type ScopedEditorInterfacesService struct {
+	service *EditorInterfacesService
+	spaceID string
+}
+
+// List returns an editor interfaces collection
+func (s *ScopedEditorInterfacesService) List() *Collection {
+	return s.service.List(s.spaceID)
+}
+
+// Get returns the editor interface of a content type
+func (s *ScopedEditorInterfacesService) Get(ctx context.Context, contentTypeID string) (*EditorInterface, error) {
+	return s.service.Get(ctx, s.spaceID, contentTypeID)
+}
+
+// Update updates the editor interface of a content type
+func (s *ScopedEditorInterfacesService) Update(ctx context.Context, contentTypeID string, e *EditorInterface) error {
+	return s.service.Update(ctx, s.spaceID, contentTypeID, e)
+}
+
+// ScopedExtensionsService is the ExtensionsService of an environment
+type ScopedExtensionsService struct {
+	service *ExtensionsService
+	spaceID string
+}
+
+// List returns an extensions collection
+func (s *ScopedExtensionsService) List() *Collection {
+	return s.service.List(s.spaceID)
+}
+
+// Get returns a single extension
+func (s *ScopedExtensionsService) Get(ctx context.Context, extensionID string) (*Extension, error) {
+	return s.service.Get(ctx, s.spaceID, extensionID)
+}
+
+// Upsert updates or creates a new extension
+func (s *ScopedExtensionsService) Upsert(ctx context.Context, e *Extension) error {
+	return s.service.Upsert(ctx, s.spaceID, e)
+}
+
+// Delete the extension
+func (s *ScopedExtensionsService) Delete(ctx context.Context, extensionID string) error {
+	return s.service.Delete(ctx, s.spaceID, extensionID)
+}
+
+// ScopedEntryTasksService is the EntryTasksService of an environment
+type ScopedEntryTasksService struct {
+	service *EntryTasksService
+	spaceID string
+}
+
+// List returns the tasks collection of an entry
+func (s *ScopedEntryTasksService) List(entryID string) *Collection {
+	return s.service.List(s.spaceID, entryID)
+}
+
+// Get returns a single task of an entry
+func (s *ScopedEntryTasksService) Get(ctx context.Context, entryID, entryTaskID string) (*EntryTask, error) {
+	return s.service.Get(ctx, s.spaceID, entryID, entryTaskID)
+}
+
+// Upsert updates or creates a new task of an entry
+func (s *ScopedEntryTasksService) Upsert(ctx context.Context, entryID string, entryTask *EntryTask) error {
+	return s.service.Upsert(ctx, s.spaceID, entryID, entryTask)
+}
+
+// Delete the task of an entry
+func (s *ScopedEntryTasksService) Delete(ctx context.Context, entryID, entryTaskID string) error {
+	return s.service.Delete(ctx, s.spaceID, entryID, entryTaskID)
+}
+
+// ScopedSnapshotsService is the SnapshotsService of an environment
+type ScopedSnapshotsService struct {
+	service *SnapshotsService
+	spaceID string
+}
+
+// ListEntrySnapshots returns the snapshots collection of an entry
+func (s *ScopedSnapshotsService) ListEntrySnapshots(entryID string) *Collection {
+	return s.service.ListEntrySnapshots(s.spaceID, entryID)
+}
+
+// GetEntrySnapshot returns a single snapshot of an entry
+func (s *ScopedSnapshotsService) GetEntrySnapshot(ctx context.Context, entryID, snapshotID string) (*EntrySnapshot, error) {
+	return s.service.GetEntrySnapshot(ctx, s.spaceID, entryID, snapshotID)
+}
+
+// ListContentTypeSnapshots returns the snapshots collection of a content type
+func (s *ScopedSnapshotsService) ListContentTypeSnapshots(contentTypeID string) *Collection {
+	return s.service.ListContentTypeSnapshots(s.spaceID, contentTypeID)
+}
+
+// GetContentTypeSnapshots returns a single snapshot of a content type
+func (s *ScopedSnapshotsService) GetContentTypeSnapshots(ctx context.Context, contentTypeID, snapshotID string) (*ContentTypeSnapshot, error) {
+	return s.service.GetContentTypeSnapshots(ctx, s.spaceID, contentTypeID, snapshotID)
+}
+
+// ScopedAppInstallationsService is the AppInstallationsService of an environment
+type ScopedAppInstallationsService struct {
+	service *AppInstallationsService
+	spaceID string
+}
+
+// List returns an app installations collection
+func (s *ScopedAppInstallationsService) List() *Collection {
+	return s.service.List(s.spaceID)
+}
+
+// Get returns a single app installation
+func (s *ScopedAppInstallationsService) Get(ctx context.Context, appInstallationID string) (*AppInstallation, error) {
+	return s.service.Get(ctx, s.spaceID, appInstallationID)
+}
+
+// Upsert updates or creates a new app installation
+func (s *ScopedAppInstallationsService) Upsert(ctx context.Context, appInstallationID string, installation *AppInstallation) error {
+	return s.service.Upsert(ctx, s.spaceID, appInstallationID, installation)
+}
+
+// Delete the app installation
+func (s *ScopedAppInstallationsService) Delete(ctx context.Context, appInstallationID string) error {
+	return s.service.Delete(ctx, s.spaceID, appInstallationID)
+}
+
+// ScopedScheduledActionsService is the ScheduledActionsService of an environment
+type ScopedScheduledActionsService struct {
+	service *ScheduledActionsService
+	spaceID string
+}
+
+// List returns the scheduled actions collection of an entry
+func (s *ScopedScheduledActionsService) List(entryID string) *Collection {
+	return s.service.List(s.spaceID, entryID)
+}
+
+// Create schedules an action on an entry
+func (s *ScopedScheduledActionsService) Create(ctx context.Context, entryID string, scheduledAction *ScheduledAction) error {
+	return s.service.Create(ctx, s.spaceID, entryID, scheduledAction)
+}
+
+// Delete the scheduled action of an entry
+func (s *ScopedScheduledActionsService) Delete(ctx context.Context, entryID, scheduledActionID string) error {
+	return s.service.Delete(ctx, s.spaceID, entryID, scheduledActionID)
+}
+
+// ScheduleRelease schedules an action on a release
+func (s *ScopedScheduledActionsService) ScheduleRelease(ctx context.Context, release *Release, action string, scheduledFor time.Time) (*ScheduledAction, error) {
+	return s.service.ScheduleRelease(ctx, s.spaceID, release, action, scheduledFor)
+}
+
+// ScopedSyncService is the SyncService of an environment
+type ScopedSyncService struct {
+	service *SyncService
+	spaceID string
+}
+
+// Initial runs an initial sync, returning every published entity of the environment
+func (s *ScopedSyncService) Initial(ctx context.Context, options *SyncOptions) (*SyncResult, error) {
+	return s.service.Initial(ctx, s.spaceID, options)
+}
+
+// Delta returns the changes since the sync which returned the given token
+func (s *ScopedSyncService) Delta(ctx context.Context, syncToken string) (*SyncResult, error) {
+	return s.service.Delta(ctx, s.spaceID, syncToken)
+}
+
+// ScopedBulkActionsService is the BulkActionsService of an environment
+type ScopedBulkActionsService struct {
+	service *BulkActionsService
+	spaceID string
+}
+
+// Publish creates a bulk action publishing the given versions of entries and assets
+func (s *ScopedBulkActionsService) Publish(ctx context.Context, entities []Entity) (*BulkAction, error) {
+	return s.service.Publish(ctx, s.spaceID, entities)
+}
+
+// Unpublish creates a bulk action unpublishing entries and assets
+func (s *ScopedBulkActionsService) Unpublish(ctx context.Context, entities []Entity) (*BulkAction, error) {
+	return s.service.Unpublish(ctx, s.spaceID, entities)
+}
+
+// Validate creates a bulk action validating that entries and assets can be published
+func (s *ScopedBulkActionsService) Validate(ctx context.Context, entities []Entity) (*BulkAction, error) {
+	return s.service.Validate(ctx, s.spaceID, entities)
+}
+
+// Get returns a single bulk action
+func (s *ScopedBulkActionsService) Get(ctx context.Context, bulkActionID string) (*BulkAction, error) {
+	return s.service.Get(ctx, s.spaceID, bulkActionID)
+}
+
+// Wait polls the bulk action until it succeeded or failed
+func (s *ScopedBulkActionsService) Wait(ctx context.Context, bulkAction *BulkAction, interval time.Duration) (*BulkAction, error) {
+	return s.service.Wait(ctx, s.spaceID, bulkAction, interval)
+}
+
+// ScopedReleasesService is the ReleasesService of an environment
+type ScopedReleasesService struct {
+	service *ReleasesService
+	spaceID string
+}
+
+// List returns releases collection
+func (s *ScopedReleasesService) List() *Collection {
+	return s.service.List(s.spaceID)
+}
+
+// Get returns a single release
+func (s *ScopedReleasesService) Get(ctx context.Context, releaseID string) (*Release, error) {
+	return s.service.Get(ctx, s.spaceID, releaseID)
+}
+
+// Entities returns the links to the entries and assets of a release
+func (s *ScopedReleasesService) Entities(ctx context.Context, releaseID string) ([]Entity, error) {
+	return s.service.Entities(ctx, s.spaceID, releaseID)
+}
+
+// Upsert updates or creates a new release
+func (s *ScopedReleasesService) Upsert(ctx context.Context, release *Release) error {
+	return s.service.Upsert(ctx, s.spaceID, release)
+}
+
+// Delete the release
+func (s *ScopedReleasesService) Delete(ctx context.Context, release *Release) error {
+	return s.service.Delete(ctx, s.spaceID, release)
+}
+
+// Publish starts a release action publishing every entity of the release
+func (s *ScopedReleasesService) Publish(ctx context.Context, release *Release) (*ReleaseAction, error) {
+	return s.service.Publish(ctx, s.spaceID, release)
+}
+
+// Unpublish starts a release action unpublishing every entity of the release
+func (s *ScopedReleasesService) Unpublish(ctx context.Context, release *Release) (*ReleaseAction, error) {
+	return s.service.Unpublish(ctx, s.spaceID, release)
+}
+
+// Validate starts a release action validating every entity of the release
+func (s *ScopedReleasesService) Validate(ctx context.Context, release *Release) (*ReleaseAction, error) {
+	return s.service.Validate(ctx, s.spaceID, release)
+}
+
+// GetAction returns a single action of a release
+func (s *ScopedReleasesService) GetAction(ctx context.Context, releaseID, actionID string) (*ReleaseAction, error) {
+	return s.service.GetAction(ctx, s.spaceID, releaseID, actionID)
+}
+
+// WaitAction polls the release action until it succeeded or failed
+func (s *ScopedReleasesService) WaitAction(ctx context.Context, action *ReleaseAction, interval time.Duration) (*ReleaseAction, error) {
+	return s.service.WaitAction(ctx, s.spaceID, action, interval)
+}
@@ -0,0 +1,101 @@
+package contentful
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpaceScope(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal("GET", r.Method)
+		assertions.Equal("/spaces/id1/webhook_definitions/7fstd9fZ9T2p3kwD49FxhI", r.URL.Path)
+
+		checkHeaders(r, assertions)
+
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("webhook_1.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.baseURL = server.URL
+
+	space := cma.Space(spaceID)
+	assertions.Equal(spaceID, space.ID())
+
+	webhook, err := space.Webhooks.Get(context.Background(), "7fstd9fZ9T2p3kwD49FxhI")
+	assertions.Nil(err)
+	assertions.Equal("webhook-name", webhook.Name)
+}
+
+func TestEnvironmentScope(t *testing.T) {
+	assertions := assert.New(t)
+
+	var mu sync.Mutex
+	paths := map[string]bool{}
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		paths[r.URL.Path] = true
+		mu.Unlock()
+
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("entry_1.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.baseURL = server.URL
+
+	staging := cma.Space(spaceID).Environment("staging")
+	qa := cma.Space(spaceID).Environment("qa")
+	assertions.Equal(spaceID, staging.SpaceID())
+	assertions.Equal("staging", staging.ID())
+
+	// scopes are used concurrently without touching the client
+	var wg sync.WaitGroup
+	for _, scope := range []*EnvironmentScope{staging, qa} {
+		wg.Add(1)
+		go func(scope *EnvironmentScope) {
+			defer wg.Done()
+
+			_, err := scope.Entries.Get(context.Background(), "5KsDBWseXY6QegucYAoacS")
+			assertions.Nil(err)
+
+			_, err = scope.Entries.List().Next(context.Background())
+			assertions.Nil(err)
+		}(scope)
+	}
+	wg.Wait()
+
+	assertions.Equal(map[string]bool{
+		"/spaces/id1/environments/staging/entries/5KsDBWseXY6QegucYAoacS": true,
+		"/spaces/id1/environments/staging/entries":                        true,
+		"/spaces/id1/environments/qa/entries/5KsDBWseXY6QegucYAoacS":      true,
+		"/spaces/id1/environments/qa/entries":                             true,
+	}, paths)
+
+	assertions.Equal("master", cma.Environment())
+	assertions.Same(cma.RateLimiter(), staging.client.RateLimiter())
+	assertions.Same(cma.client, staging.client.client)
+
+	// the services of the client of a scope are bound to its environment
+	assertions.Same(staging.client, staging.client.Entries.c)
+	assertions.Equal("staging", staging.client.Entries.c.environment(context.Background()))
+	assertions.Same(cma, cma.Entries.c)
+}
@@ -1,8 +1,8 @@
 package contentful
 
 import (
+	"context"
 	"fmt"
-	"net/http"
 	"net/url"
 )
 
@@ -45,70 +45,52 @@ type ContentTypeFields struct {
 
 // ListEntrySnapshots returns snapshot collection
 func (service *SnapshotsService) ListEntrySnapshots(spaceID, entryID string) *Collection {
-	path := fmt.Sprintf("/spaces/%s/environments/%s/entries/%s/snapshots", spaceID, service.c.Environment, entryID)
-
-	req, err := service.c.newRequest(http.MethodGet, path, nil, nil)
-	if err != nil {
-		return &Collection{}
-	}
-
-	col := NewCollection(&CollectionOptions{})
-	col.c = service.c
-	col.req = req
-
-	return col
+	return service.c.environmentCollection(func(environment string) string {
+		return fmt.Sprintf("/spaces/%s/environments/%s/entries/%s/snapshots", spaceID, environment, entryID)
+	})
 }
 
 // GetEntrySnapshot returns a single snapshot of an entry
-func (service *SnapshotsService) GetEntrySnapshot(spaceID, entryID, snapshotID string) (*EntrySnapshot, error) {
-	path := fmt.Sprintf("/spaces/%s/environments/%s/entries/%s/snapshots/%s", spaceID, service.c.Environment, entryID, snapshotID)
+func (service *SnapshotsService) GetEntrySnapshot(ctx context.Context, spaceID, entryID, snapshotID string) (*EntrySnapshot, error) {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/entries/%s/snapshots/%s", spaceID, service.c.environment(ctx), entryID, snapshotID)
 	query := url.Values{}
 	method := "GET"
 
-	req, err := service.c.newRequest(method, path, query, nil)
+	req, err := service.c.newRequest(ctx, method, path, query, nil)
 	if err != nil {
 		return &EntrySnapshot{}, err
 	}
 
 	var entrySnapshot EntrySnapshot
-	if ok := service.c.do(req, &entrySnapshot); ok != nil {
+	if err := service.c.do(req, &entrySnapshot); err != nil {
 		return nil, err
 	}
 
-	return &entrySnapshot, err
+	return &entrySnapshot, nil
 }
 
 // ListContentTypeSnapshots returns snapshot collection
 func (service *SnapshotsService) ListContentTypeSnapshots(spaceID, contentTypeID string) *Collection {
-	path := fmt.Sprintf("/spaces/%s/environments/%s/content_types/%s/snapshots", spaceID, service.c.Environment, contentTypeID)
-
-	req, err := service.c.newRequest(http.MethodGet, path, nil, nil)
-	if err != nil {
-		return &Collection{}
-	}
-
-	col := NewCollection(&CollectionOptions{})
-	col.c = service.c
-	col.req = req
-
-	return col
+	return service.c.environmentCollection(func(environment string) string {
+		return fmt.Sprintf("/spaces/%s/environments/%s/content_types/%s/snapshots", spaceID, environment, contentTypeID)
+	})
 }
 
 // GetContentTypeSnapshots returns a single snapshot of an entry
-func (service *SnapshotsService) GetContentTypeSnapshots(spaceID, contentTypeID, snapshotID string) (*ContentTypeSnapshot, error) {
-	path := fmt.Sprintf("/spaces/%s/environments/%s/content_types/%s/snapshots/%s", spaceID, service.c.Environment, contentTypeID, snapshotID)
+func (service *SnapshotsService) GetContentTypeSnapshots(ctx context.Context, spaceID, contentTypeID, snapshotID string) (*ContentTypeSnapshot, error) {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/content_types/%s/snapshots/%s", spaceID, service.c.environment(ctx), contentTypeID, snapshotID)
 	query := url.Values{}
 	method := "GET"
 
-	req, err := service.c.newRequest(method, path, query, nil)
+	req, err := service.c.newRequest(ctx, method, path, query, nil)
 	if err != nil {
 		return &ContentTypeSnapshot{}, err
 	}
 
 	var contentTypeSnapshot ContentTypeSnapshot
-	if ok := service.c.do(req, &contentTypeSnapshot); ok != nil {
+	if err := service.c.do(req, &contentTypeSnapshot); err != nil {
 		return nil, err
 	}
 
-	return &contentTypeSnapshot, err
+	return &contentTypeSnapshot, nil
 }
@@ -1,6 +1,7 @@
 package contentful
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -29,9 +30,9 @@ func TestSnapshotsService_ListEntrySnapshots(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	collection, err := cma.Snapshots.ListEntrySnapshots(spaceID, "hfM9RCJIk0wIm06WkEOQY").Next()
+	collection, err := cma.Snapshots.ListEntrySnapshots(spaceID, "hfM9RCJIk0wIm06WkEOQY").Next(context.Background())
 	assertions.Nil(err)
 	entrySnapshot := collection.ToEntrySnapshot()
 	assertions.Equal(1, len(entrySnapshot))
@@ -58,9 +59,9 @@ func TestSnapshotsService_GetEntrySnapshot(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	entrySnapshot, err := cma.Snapshots.GetEntrySnapshot(spaceID, "hfM9RCJIk0wIm06WkEOQY", "4FLrUHftHW3v2BLi9fzfjU")
+	entrySnapshot, err := cma.Snapshots.GetEntrySnapshot(context.Background(), spaceID, "hfM9RCJIk0wIm06WkEOQY", "4FLrUHftHW3v2BLi9fzfjU")
 	assertions.Nil(err)
 	assertions.Equal("Hello, World!", entrySnapshot.EntrySnapshotDetail.Fields["title"].(map[string]interface{})["en-US"])
 }
@@ -85,10 +86,11 @@ func TestSnapshotsService_GetEntrySnapshot_2(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	_, err = cma.Snapshots.GetEntrySnapshot(spaceID, "hfM9RCJIk0wIm06WkEOQY", "4FLrUHftHW3v2BLi9fzfjU")
-	assertions.Nil(err)
+	snapshot, err := cma.Snapshots.GetEntrySnapshot(context.Background(), spaceID, "hfM9RCJIk0wIm06WkEOQY", "4FLrUHftHW3v2BLi9fzfjU")
+	assertions.NotNil(err)
+	assertions.Nil(snapshot)
 }
 
 func TestSnapshotsService_ListContentTypeSnapshots(t *testing.T) {
@@ -111,9 +113,9 @@ func TestSnapshotsService_ListContentTypeSnapshots(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	collection, err := cma.Snapshots.ListContentTypeSnapshots(spaceID, "hfM9RCJIk0wIm06WkEOQY").Next()
+	collection, err := cma.Snapshots.ListContentTypeSnapshots(spaceID, "hfM9RCJIk0wIm06WkEOQY").Next(context.Background())
 	assertions.Nil(err)
 	entrySnapshot := collection.ToContentTypeSnapshot()
 	assertions.Equal(1, len(entrySnapshot))
@@ -140,9 +142,9 @@ func TestSnapshotsService_GetContentTypeSnapshots(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	entrySnapshot, err := cma.Snapshots.GetContentTypeSnapshots(spaceID, "hfM9RCJIk0wIm06WkEOQY", "4FLrUHftHW3v2BLi9fzfjU")
+	entrySnapshot, err := cma.Snapshots.GetContentTypeSnapshots(context.Background(), spaceID, "hfM9RCJIk0wIm06WkEOQY", "4FLrUHftHW3v2BLi9fzfjU")
 	assertions.Nil(err)
 	assertions.Equal("Blog Post", entrySnapshot.ContentTypeSnapshotDetail.Name)
 
@@ -168,9 +170,10 @@ func TestSnapshotsService_GetContentTypeSnapshots_2(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	_, err = cma.Snapshots.GetContentTypeSnapshots(spaceID, "hfM9RCJIk0wIm06WkEOQY", "4FLrUHftHW3v2BLi9fzfjU")
-	assertions.Nil(err)
+	snapshot, err := cma.Snapshots.GetContentTypeSnapshots(context.Background(), spaceID, "hfM9RCJIk0wIm06WkEOQY", "4FLrUHftHW3v2BLi9fzfjU")
+	assertions.NotNil(err)
+	assertions.Nil(snapshot)
 
 }
@@ -2,6 +2,7 @@ package contentful
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -41,7 +42,7 @@ func (space *Space) GetVersion() int {
 
 // List creates a spaces collection
 func (service *SpacesService) List() *Collection {
-	req, _ := service.c.newRequest("GET", "/spaces", nil, nil)
+	req, _ := service.c.newRequest(context.Background(), "GET", "/spaces", nil, nil)
 
 	col := NewCollection(&CollectionOptions{})
 	col.c = service.c
@@ -51,23 +52,23 @@ func (service *SpacesService) List() *Collection {
 }
 
 // Get returns a single space entity
-func (service *SpacesService) Get(spaceID string) (*Space, error) {
+func (service *SpacesService) Get(ctx context.Context, spaceID string) (*Space, error) {
 	path := fmt.Sprintf("/spaces/%s", spaceID)
-	req, err := service.c.newRequest(http.MethodGet, path, nil, nil)
+	req, err := service.c.newRequest(ctx, http.MethodGet, path, nil, nil)
 	if err != nil {
 		return &Space{}, err
 	}
 
 	var space Space
-	if ok := service.c.do(req, &space); ok != nil {
-		return &Space{}, ok
+	if err := service.c.do(req, &space); err != nil {
+		return nil, err
 	}
 
 	return &space, nil
 }
 
 // Upsert updates or creates a new space
-func (service *SpacesService) Upsert(space *Space) error {
+func (service *SpacesService) Upsert(ctx context.Context, space *Space) error {
 	bytesArray, err := json.Marshal(space)
 	if err != nil {
 		return err
@@ -84,7 +85,7 @@ func (service *SpacesService) Upsert(space *Space) error {
 		method = http.MethodPost
 	}
 
-	req, err := service.c.newRequest(method, path, nil, bytes.NewReader(bytesArray))
+	req, err := service.c.newRequest(ctx, method, path, nil, bytes.NewReader(bytesArray))
 	if err != nil {
 		return err
 	}
@@ -95,10 +96,10 @@ func (service *SpacesService) Upsert(space *Space) error {
 }
 
 // Delete the given space
-func (service *SpacesService) Delete(space *Space) error {
+func (service *SpacesService) Delete(ctx context.Context, space *Space) error {
 	path := fmt.Sprintf("/spaces/%s", space.Sys.ID)
 
-	req, err := service.c.newRequest(http.MethodDelete, path, nil, nil)
+	req, err := service.c.newRequest(ctx, http.MethodDelete, path, nil, nil)
 	if err != nil {
 		return err
 	}
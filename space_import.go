@@ -0,0 +1,525 @@
+package contentful
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+)
+
+// noinspection GoUnusedConst
+const (
+	// SpaceImportCreate the resource does not exist in the target and is created
+	SpaceImportCreate = "create"
+
+	// SpaceImportUpdate the resource differs from the one of the target and is updated
+	SpaceImportUpdate = "update"
+
+	// SpaceImportUnchanged the resource is the same in the target and is left as is
+	SpaceImportUnchanged = "unchanged"
+)
+
+// Export is a contentful-export JSON document
+type Export struct {
+	ContentTypes     []*ContentType     `json:"contentTypes,omitempty"`
+	EditorInterfaces []*EditorInterface `json:"editorInterfaces,omitempty"`
+	Entries          []*Entry           `json:"entries,omitempty"`
+	Assets           []*Asset           `json:"assets,omitempty"`
+	Locales          []*Locale          `json:"locales,omitempty"`
+	Webhooks         []*Webhook         `json:"webhooks,omitempty"`
+	Roles            []*Role            `json:"roles,omitempty"`
+}
+
+// SpaceImportOptions configure a SpaceImporter
+type SpaceImportOptions struct {
+	// DryRun compares the export with the target and reports the changes
+	// without applying them
+	DryRun bool
+
+	// SkipContentModel skips locales, content types and editor interfaces
+	SkipContentModel bool
+
+	// SkipContent skips entries and assets
+	SkipContent bool
+
+	// SkipPublish does not publish the entries and the assets which are published in the export
+	SkipPublish bool
+
+	// Content configures the import of entries and assets
+	Content *ImportOptions
+}
+
+// SpaceImportChange is a change of a resource of the target
+type SpaceImportChange struct {
+	Type   string
+	ID     string
+	Action string
+	Err    error
+}
+
+// SpaceImportReport lists the changes of an import, in the order they are applied
+type SpaceImportReport struct {
+	DryRun  bool
+	Changes []*SpaceImportChange
+}
+
+// SpaceImporter recreates the content of a contentful-export document in the
+// space and the environment of a client. Locales, content types, which are
+// then activated, and editor interfaces are imported before assets, whose
+// files are uploaded and processed, and entries, in the order of their links.
+type SpaceImporter struct {
+	client  *Client
+	spaceID string
+	options SpaceImportOptions
+}
+
+// NewSpaceImporter returns an importer into the space and the environment of the client
+func NewSpaceImporter(c *Client, spaceID string, options *SpaceImportOptions) *SpaceImporter {
+	importer := &SpaceImporter{client: c, spaceID: spaceID}
+	if options != nil {
+		importer.options = *options
+	}
+
+	return importer
+}
+
+// ReadExport decodes a contentful-export JSON document
+func ReadExport(r io.Reader) (*Export, error) {
+	var export Export
+	if err := json.NewDecoder(r).Decode(&export); err != nil {
+		return nil, err
+	}
+
+	return &export, nil
+}
+
+// Changed returns the changes which are not SpaceImportUnchanged
+func (report *SpaceImportReport) Changed() []*SpaceImportChange {
+	var changes []*SpaceImportChange
+	for _, change := range report.Changes {
+		if change.Action != SpaceImportUnchanged {
+			changes = append(changes, change)
+		}
+	}
+
+	return changes
+}
+
+// Err joins the errors of the changes which failed
+func (report *SpaceImportReport) Err() error {
+	var errs []error
+	for _, change := range report.Changes {
+		if change.Err != nil {
+			errs = append(errs, fmt.Errorf("%s %s %s: %w", change.Action, change.Type, change.ID, change.Err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// String lists the changes, one per line
+func (report *SpaceImportReport) String() string {
+	msg := strings.Builder{}
+	for _, change := range report.Changed() {
+		msg.WriteString(fmt.Sprintf("%s %s %s", change.Action, change.Type, change.ID))
+		if change.Err != nil {
+			msg.WriteString(": " + change.Err.Error())
+		}
+		msg.WriteString("\n")
+	}
+
+	return msg.String()
+}
+
+// Import reads a contentful-export document and imports it
+func (importer *SpaceImporter) Import(ctx context.Context, r io.Reader) (*SpaceImportReport, error) {
+	export, err := ReadExport(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return importer.ImportExport(ctx, export)
+}
+
+// ImportExport imports an export. The error is returned when the target could
+// not be read, the errors of the changes are part of the report.
+func (importer *SpaceImporter) ImportExport(ctx context.Context, export *Export) (*SpaceImportReport, error) {
+	report := &SpaceImportReport{DryRun: importer.options.DryRun}
+
+	if !importer.options.SkipContentModel {
+		if err := importer.importLocales(ctx, export.Locales, report); err != nil {
+			return report, err
+		}
+
+		if err := importer.importContentTypes(ctx, export.ContentTypes, report); err != nil {
+			return report, err
+		}
+
+		if err := importer.importEditorInterfaces(ctx, export.EditorInterfaces, report); err != nil {
+			return report, err
+		}
+	}
+
+	if !importer.options.SkipContent {
+		if err := importer.importContent(ctx, export, report); err != nil {
+			return report, err
+		}
+	}
+
+	return report, nil
+}
+
+func (importer *SpaceImporter) importLocales(ctx context.Context, locales []*Locale, report *SpaceImportReport) error {
+	current, err := All[*Locale](ctx, importer.client.Locales.List(importer.spaceID))
+	if err != nil {
+		return err
+	}
+
+	byCode := map[string]*Locale{}
+	for _, locale := range current {
+		byCode[locale.Code] = locale
+	}
+
+	for _, locale := range locales {
+		change := &SpaceImportChange{Type: "Locale", ID: locale.Code, Action: SpaceImportCreate}
+		report.Changes = append(report.Changes, change)
+
+		target, ok := byCode[locale.Code]
+		if ok {
+			change.Action = SpaceImportUnchanged
+			if target.Name != locale.Name || target.FallbackCode != locale.FallbackCode || target.Optional != locale.Optional ||
+				target.CDA != locale.CDA || target.CMA != locale.CMA {
+				change.Action = SpaceImportUpdate
+			}
+		}
+
+		if importer.options.DryRun || change.Action == SpaceImportUnchanged {
+			continue
+		}
+
+		imported := *locale
+		imported.Sys = nil
+		if ok {
+			imported.Sys = target.Sys
+			imported.Default = target.Default
+		} else {
+			// a space has a single default locale, which already exists
+			imported.Default = false
+		}
+
+		change.Err = importer.client.Locales.Upsert(ctx, importer.spaceID, &imported)
+	}
+
+	return nil
+}
+
+func (importer *SpaceImporter) importContentTypes(ctx context.Context, contentTypes []*ContentType, report *SpaceImportReport) error {
+	current, err := All[*ContentType](ctx, importer.client.ContentTypes.List(importer.spaceID))
+	if err != nil {
+		return err
+	}
+
+	byID := map[string]*ContentType{}
+	for _, contentType := range current {
+		byID[contentType.Sys.ID] = contentType
+	}
+
+	var upserted []*ContentType
+	var changes []*SpaceImportChange
+	for _, contentType := range contentTypes {
+		change := &SpaceImportChange{Type: "ContentType", ID: contentType.Sys.ID, Action: SpaceImportCreate}
+		report.Changes = append(report.Changes, change)
+
+		version := 0
+		if target, ok := byID[contentType.Sys.ID]; ok {
+			change.Action = SpaceImportUnchanged
+			if !sameJSON(contentTypeModel(target), contentTypeModel(contentType)) {
+				change.Action = SpaceImportUpdate
+			}
+			version = target.Sys.Version
+		}
+
+		if importer.options.DryRun || change.Action == SpaceImportUnchanged {
+			continue
+		}
+
+		imported := &ContentType{
+			Sys:          &Sys{ID: contentType.Sys.ID, Version: version},
+			Name:         contentType.Name,
+			Description:  contentType.Description,
+			Fields:       contentType.Fields,
+			DisplayField: contentType.DisplayField,
+		}
+
+		if change.Err = importer.client.ContentTypes.Upsert(ctx, importer.spaceID, imported); change.Err == nil {
+			upserted = append(upserted, imported)
+			changes = append(changes, change)
+		}
+	}
+
+	// content types are activated once they all exist, as they may link to each other
+	for i, contentType := range upserted {
+		changes[i].Err = importer.client.ContentTypes.Activate(ctx, importer.spaceID, contentType)
+	}
+
+	return nil
+}
+
+func (importer *SpaceImporter) importEditorInterfaces(ctx context.Context, editorInterfaces []*EditorInterface, report *SpaceImportReport) error {
+	current, err := All[*EditorInterface](ctx, importer.client.EditorInterfaces.List(importer.spaceID))
+	if err != nil {
+		return err
+	}
+
+	byContentType := map[string]*EditorInterface{}
+	for _, editorInterface := range current {
+		if id := editorInterfaceContentType(editorInterface); id != "" {
+			byContentType[id] = editorInterface
+		}
+	}
+
+	for _, editorInterface := range editorInterfaces {
+		contentTypeID := editorInterfaceContentType(editorInterface)
+		change := &SpaceImportChange{Type: "EditorInterface", ID: contentTypeID, Action: SpaceImportCreate}
+		report.Changes = append(report.Changes, change)
+
+		target, ok := byContentType[contentTypeID]
+		if ok {
+			change.Action = SpaceImportUnchanged
+			if !sameJSON(target.Controls, editorInterface.Controls) || !sameJSON(target.SideBar, editorInterface.SideBar) {
+				change.Action = SpaceImportUpdate
+			}
+		}
+
+		if importer.options.DryRun || change.Action == SpaceImportUnchanged {
+			continue
+		}
+
+		// the editor interface of a new content type is created with it
+		if !ok {
+			if target, change.Err = importer.client.EditorInterfaces.Get(ctx, importer.spaceID, contentTypeID); change.Err != nil {
+				continue
+			}
+		}
+
+		imported := &EditorInterface{
+			Sys:      target.Sys,
+			Controls: editorInterface.Controls,
+			SideBar:  editorInterface.SideBar,
+		}
+		change.Err = importer.client.EditorInterfaces.Update(ctx, importer.spaceID, contentTypeID, imported)
+	}
+
+	return nil
+}
+
+func (importer *SpaceImporter) importContent(ctx context.Context, export *Export, report *SpaceImportReport) error {
+	assets := map[string]*Asset{}
+	it := Iterate[*Asset](ctx, importer.client.Assets.List(importer.spaceID).WithKeysetPagination())
+	for it.Next() {
+		assets[it.Value().Sys.ID] = it.Value()
+	}
+	if err := it.Err(); err != nil {
+		return err
+	}
+
+	entries := map[string]*Entry{}
+	entryIt := Iterate[*Entry](ctx, importer.client.Entries.List(importer.spaceID).WithKeysetPagination())
+	for entryIt.Next() {
+		entries[entryIt.Value().Sys.ID] = entryIt.Value()
+	}
+	if err := entryIt.Err(); err != nil {
+		return err
+	}
+
+	var items []*ImportItem
+	changes := map[*ImportItem]*SpaceImportChange{}
+
+	for _, asset := range export.Assets {
+		change := &SpaceImportChange{Type: "Asset", ID: asset.Sys.ID, Action: SpaceImportCreate}
+		report.Changes = append(report.Changes, change)
+
+		target, ok := assets[asset.Sys.ID]
+		if ok {
+			change.Action = SpaceImportUnchanged
+			if !sameAsset(target, asset) {
+				change.Action = SpaceImportUpdate
+			}
+		}
+
+		if change.Action == SpaceImportUnchanged {
+			continue
+		}
+
+		imported := &Asset{
+			Sys:      &Sys{ID: asset.Sys.ID},
+			Metadata: asset.Metadata,
+			Fields:   importedAssetFields(asset.Fields, target),
+		}
+		if ok {
+			imported.Sys.Version = target.Sys.Version
+		}
+
+		item := &ImportItem{Asset: imported, Publish: published(asset.Sys) && !importer.options.SkipPublish}
+		items = append(items, item)
+		changes[item] = change
+	}
+
+	for _, entry := range export.Entries {
+		change := &SpaceImportChange{Type: "Entry", ID: entry.Sys.ID, Action: SpaceImportCreate}
+		report.Changes = append(report.Changes, change)
+
+		target, ok := entries[entry.Sys.ID]
+		if ok {
+			change.Action = SpaceImportUnchanged
+			if !sameJSON(target.Fields, entry.Fields) {
+				change.Action = SpaceImportUpdate
+			}
+		}
+
+		if change.Action == SpaceImportUnchanged {
+			continue
+		}
+
+		imported := &Entry{
+			Sys:    &Sys{ID: entry.Sys.ID, ContentType: entry.Sys.ContentType},
+			Fields: entry.Fields,
+		}
+		if ok {
+			imported.Sys.Version = target.Sys.Version
+		}
+
+		item := &ImportItem{Entry: imported, Publish: published(entry.Sys) && !importer.options.SkipPublish}
+		items = append(items, item)
+		changes[item] = change
+	}
+
+	if importer.options.DryRun || len(items) == 0 {
+		return nil
+	}
+
+	result, err := NewImporter(importer.client, importer.spaceID, importer.options.Content).ImportAll(ctx, items)
+	for _, itemResult := range result.Results {
+		changes[itemResult.Item].Err = itemResult.Err
+	}
+
+	return err
+}
+
+// contentTypeModel returns the writable part of a content type
+func contentTypeModel(contentType *ContentType) *ContentType {
+	return &ContentType{
+		Name:         contentType.Name,
+		Description:  contentType.Description,
+		Fields:       contentType.Fields,
+		DisplayField: contentType.DisplayField,
+	}
+}
+
+func editorInterfaceContentType(editorInterface *EditorInterface) string {
+	if editorInterface.Sys == nil || editorInterface.Sys.ContentType == nil || editorInterface.Sys.ContentType.Sys == nil {
+		return ""
+	}
+
+	return editorInterface.Sys.ContentType.Sys.ID
+}
+
+// importedAssetFields returns the fields of an exported asset whose files are
+// uploaded from their url, unless the target already has the same files
+func importedAssetFields(fields *AssetFields, target *Asset) *AssetFields {
+	if fields == nil {
+		return nil
+	}
+
+	imported := &AssetFields{
+		Title:       fields.Title,
+		Description: fields.Description,
+	}
+
+	if target != nil && target.Fields != nil && sameFiles(target.Fields.File, fields.File) {
+		imported.File = target.Fields.File
+		return imported
+	}
+
+	if fields.File != nil {
+		imported.File = map[string]*File{}
+	}
+
+	for locale, file := range fields.File {
+		if file == nil {
+			continue
+		}
+
+		upload := file.UploadURL
+		if upload == "" && file.URL != "" {
+			upload = file.URL
+			if strings.HasPrefix(upload, "//") {
+				upload = "https:" + upload
+			}
+		}
+
+		imported.File[locale] = &File{
+			UploadURL:   upload,
+			UploadFrom:  file.UploadFrom,
+			FileName:    file.FileName,
+			ContentType: file.ContentType,
+		}
+	}
+
+	return imported
+}
+
+// sameAsset compares the title, the description and the files of two assets
+func sameAsset(a, b *Asset) bool {
+	if a.Fields == nil || b.Fields == nil {
+		return a.Fields == b.Fields
+	}
+
+	return reflect.DeepEqual(a.Fields.Title, b.Fields.Title) &&
+		reflect.DeepEqual(a.Fields.Description, b.Fields.Description) &&
+		sameFiles(a.Fields.File, b.Fields.File)
+}
+
+// sameFiles compares the names and types of the files of each locale, urls
+// differ between spaces
+func sameFiles(a, b map[string]*File) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for locale, file := range a {
+		other, ok := b[locale]
+		if !ok || (file == nil) != (other == nil) {
+			return false
+		}
+
+		if file != nil && (file.FileName != other.FileName || file.ContentType != other.ContentType) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// published reports whether the sys is the one of a published entity
+func published(sys *Sys) bool {
+	return sys != nil && (sys.PublishedVersion > 0 || sys.PublishedAt != "")
+}
+
+// sameJSON compares the JSON representations of two values
+func sameJSON(a, b interface{}) bool {
+	aJSON, aErr := json.Marshal(a)
+	bJSON, bErr := json.Marshal(b)
+	if aErr != nil || bErr != nil {
+		return false
+	}
+
+	var aValue, bValue interface{}
+	_ = json.Unmarshal(aJSON, &aValue)
+	_ = json.Unmarshal(bJSON, &bValue)
+
+	return reflect.DeepEqual(aValue, bValue)
+}
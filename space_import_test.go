@@ -0,0 +1,224 @@
+package contentful
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newTargetServer serves the target of an import, responses are keyed by
+// method and path relative to the environment, requests are recorded. A
+// status code as response answers with a not found error.
+func newTargetServer(t *testing.T, responses map[string]interface{}) (*httptest.Server, func() []string) {
+	var mu sync.Mutex
+	var requests []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		checkHeaders(r, assert.New(t))
+
+		key := r.Method + " " + strings.TrimPrefix(r.URL.Path, "/spaces/"+spaceID+"/environments/master/")
+
+		mu.Lock()
+		requests = append(requests, key)
+		mu.Unlock()
+
+		response, ok := responses[key]
+		if status, ok := response.(int); ok {
+			w.WriteHeader(status)
+			_, _ = fmt.Fprintln(w, readTestData("error_notfound.json"))
+			return
+		}
+
+		if !ok {
+			// writes echo the body with the id of the path and a new version
+			body := map[string]interface{}{}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			body["sys"] = map[string]interface{}{"id": strings.Split(key, "/")[1], "version": 2}
+			response = body
+		}
+
+		// the first page of collections, the next ones are empty
+		if items, ok := response.([]interface{}); ok {
+			if r.URL.Query().Get("skip") != "" && r.URL.Query().Get("skip") != "0" {
+				items = nil
+			}
+
+			response = map[string]interface{}{"sys": map[string]interface{}{"type": "Array"}, "total": len(items), "items": items}
+		}
+
+		w.WriteHeader(200)
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+
+	return server, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return append([]string(nil), requests...)
+	}
+}
+
+// targetResponses is a target with the locale, the author and the editor
+// interface of the export, and a post content type with another name
+func targetResponses(t *testing.T) map[string]interface{} {
+	var export map[string][]interface{}
+	assert.Nil(t, json.Unmarshal([]byte(readTestData("export.json")), &export))
+
+	contentType := export["contentTypes"][0].(map[string]interface{})
+	contentType["name"] = "Article"
+	contentType["sys"].(map[string]interface{})["version"] = 4.0
+
+	locale := export["locales"][0].(map[string]interface{})
+	locale["contentDeliveryApi"] = false
+	locale["contentManagementApi"] = false
+
+	return map[string]interface{}{
+		"GET locales":           export["locales"],
+		"GET content_types":     []interface{}{contentType},
+		"GET editor_interfaces": export["editorInterfaces"],
+		"GET entries":           []interface{}{export["entries"][1]},
+		"GET assets":            []interface{}{},
+		"GET assets/cover-1": map[string]interface{}{
+			"sys": map[string]interface{}{"id": "cover-1", "version": 3},
+			"fields": map[string]interface{}{
+				"file": map[string]interface{}{"en-US": map[string]interface{}{"url": "//images.test/cover.png"}},
+			},
+		},
+	}
+}
+
+func TestSpaceImporter_DryRun(t *testing.T) {
+	assertions := assert.New(t)
+
+	server, requests := newTargetServer(t, targetResponses(t))
+	defer server.Close()
+
+	cma = NewCMA(CMAToken, WithBaseURL(server.URL))
+
+	f, err := os.Open("testdata/export.json")
+	assertions.Nil(err)
+	defer f.Close()
+
+	report, err := NewSpaceImporter(cma, spaceID, &SpaceImportOptions{DryRun: true}).Import(context.Background(), f)
+	assertions.Nil(err)
+	assertions.Nil(report.Err())
+	assertions.True(report.DryRun)
+	assertions.Len(report.Changes, 6)
+	assertions.Equal("update ContentType post\ncreate Asset cover-1\ncreate Entry post-1\n", report.String())
+
+	for _, request := range requests() {
+		assertions.True(strings.HasPrefix(request, "GET "), request)
+	}
+}
+
+func TestSpaceImporter_Import(t *testing.T) {
+	assertions := assert.New(t)
+
+	server, requests := newTargetServer(t, targetResponses(t))
+	defer server.Close()
+
+	cma = NewCMA(CMAToken, WithBaseURL(server.URL))
+
+	f, err := os.Open("testdata/export.json")
+	assertions.Nil(err)
+	defer f.Close()
+
+	importer := cma.Space(spaceID).Environment("master").SpaceImporter(&SpaceImportOptions{
+		Content: &ImportOptions{ProcessingInterval: time.Millisecond},
+	})
+
+	report, err := importer.Import(context.Background(), f)
+	assertions.Nil(err)
+	assertions.Nil(report.Err())
+	assertions.Len(report.Changed(), 3)
+
+	var writes []string
+	for _, request := range requests() {
+		if !strings.HasPrefix(request, "GET ") || request == "GET assets/cover-1" {
+			writes = append(writes, request)
+		}
+	}
+
+	// the asset is uploaded, processed then published before the entry linking to it
+	assertions.Equal([]string{
+		"PUT content_types/post",
+		"PUT content_types/post/published",
+		"PUT assets/cover-1",
+		"PUT assets/cover-1/files/en-US/process",
+		"GET assets/cover-1",
+		"PUT assets/cover-1/published",
+		"PUT entries/post-1",
+		"PUT entries/post-1/published",
+	}, writes)
+}
+
+func TestSpaceImporter_EditorInterfaceNotFound(t *testing.T) {
+	assertions := assert.New(t)
+
+	responses := targetResponses(t)
+	responses["GET editor_interfaces"] = []interface{}{}
+	responses["GET content_types/post/editor_interface"] = 404
+
+	server, requests := newTargetServer(t, responses)
+	defer server.Close()
+
+	cma = NewCMA(CMAToken, WithBaseURL(server.URL))
+
+	f, err := os.Open("testdata/export.json")
+	assertions.Nil(err)
+	defer f.Close()
+
+	report, err := NewSpaceImporter(cma, spaceID, &SpaceImportOptions{
+		Content: &ImportOptions{ProcessingInterval: time.Millisecond},
+	}).Import(context.Background(), f)
+	assertions.Nil(err)
+
+	var notFound NotFoundError
+	assertions.True(errors.As(report.Err(), &notFound))
+	assertions.NotContains(requests(), "PUT content_types/post/editor_interface")
+}
+
+func TestSpaceImporter_ContentTypeVersion(t *testing.T) {
+	assertions := assert.New(t)
+
+	responses := targetResponses(t)
+	var versions []string
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PUT" {
+			versions = append(versions, r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]+"@"+r.Header.Get("X-Contentful-Version"))
+		}
+
+		items := responses[r.Method+" "+strings.TrimPrefix(r.URL.Path, "/spaces/"+spaceID+"/environments/master/")]
+		if items == nil {
+			_, _ = fmt.Fprintln(w, `{"sys": {"id": "post", "version": 5}}`)
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"items": items, "total": 1})
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	cma = NewCMA(CMAToken, WithBaseURL(server.URL))
+
+	export, err := ReadExport(strings.NewReader(readTestData("export.json")))
+	assertions.Nil(err)
+
+	report, err := NewSpaceImporter(cma, spaceID, &SpaceImportOptions{SkipContent: true}).ImportExport(context.Background(), export)
+	assertions.Nil(err)
+	assertions.Nil(report.Err())
+
+	// the content type is updated at the version of the target, then activated
+	assertions.Equal([]string{"post@4", "published@5"}, versions)
+}
@@ -0,0 +1,200 @@
+package contentful
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// SyncService service
+type SyncService service
+
+// noinspection GoUnusedConst
+const (
+	// SyncTypeAll syncs every entity and deletion
+	SyncTypeAll = "all"
+
+	// SyncTypeEntry syncs entries only
+	SyncTypeEntry = "Entry"
+
+	// SyncTypeAsset syncs assets only
+	SyncTypeAsset = "Asset"
+
+	// SyncTypeDeletion syncs deletions only
+	SyncTypeDeletion = "Deletion"
+
+	// SyncTypeDeletedEntry syncs entry deletions only
+	SyncTypeDeletedEntry = "DeletedEntry"
+
+	// SyncTypeDeletedAsset syncs asset deletions only
+	SyncTypeDeletedAsset = "DeletedAsset"
+)
+
+// SyncOptions holds the options of an initial sync
+type SyncOptions struct {
+	// Type restricts the synced entities, one of the SyncType constants
+	Type string
+
+	// ContentType restricts the synced entries to a content type, Type must be SyncTypeEntry
+	ContentType string
+
+	// Limit is the number of items per page
+	Limit int
+}
+
+// DeletedEntry model
+type DeletedEntry struct {
+	Sys *Sys `json:"sys"`
+}
+
+// DeletedAsset model
+type DeletedAsset struct {
+	Sys *Sys `json:"sys"`
+}
+
+// SyncResult holds the changes since the previous sync
+type SyncResult struct {
+	Entries        []*Entry
+	Assets         []*Asset
+	DeletedEntries []*DeletedEntry
+	DeletedAssets  []*DeletedAsset
+
+	// Token is the sync token to pass to Delta on the next sync
+	Token string
+}
+
+// syncPage is a single page of a sync response
+type syncPage struct {
+	Items       []json.RawMessage `json:"items"`
+	NextPageURL string            `json:"nextPageUrl"`
+	NextSyncURL string            `json:"nextSyncUrl"`
+}
+
+// Initial runs an initial sync, returning every published entity of the environment
+func (service *SyncService) Initial(ctx context.Context, spaceID string, options *SyncOptions) (*SyncResult, error) {
+	query := url.Values{}
+	query.Set("initial", "true")
+
+	if options != nil {
+		if options.Type != "" {
+			query.Set("type", options.Type)
+		}
+
+		if options.ContentType != "" {
+			query.Set("content_type", options.ContentType)
+		}
+
+		if options.Limit > 0 {
+			query.Set("limit", strconv.Itoa(options.Limit))
+		}
+	}
+
+	return service.run(ctx, spaceID, query)
+}
+
+// Delta returns the changes since the sync which returned the given token
+func (service *SyncService) Delta(ctx context.Context, spaceID, syncToken string) (*SyncResult, error) {
+	query := url.Values{}
+	query.Set("sync_token", syncToken)
+
+	return service.run(ctx, spaceID, query)
+}
+
+func (service *SyncService) run(ctx context.Context, spaceID string, query url.Values) (*SyncResult, error) {
+	path := fmt.Sprintf("/spaces/%s/environments/%s/sync", spaceID, service.c.environment(ctx))
+	result := &SyncResult{}
+
+	for {
+		req, err := service.c.newRequest(ctx, http.MethodGet, path, query, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var page syncPage
+		if err := service.c.do(req, &page); err != nil {
+			return nil, err
+		}
+
+		if err := result.add(page.Items); err != nil {
+			return nil, err
+		}
+
+		if page.NextSyncURL != "" {
+			token, err := syncToken(page.NextSyncURL)
+			if err != nil {
+				return nil, err
+			}
+
+			result.Token = token
+
+			return result, nil
+		}
+
+		token, err := syncToken(page.NextPageURL)
+		if err != nil {
+			return nil, err
+		}
+
+		query = url.Values{}
+		query.Set("sync_token", token)
+	}
+}
+
+// add sorts the items of a page by their type
+func (result *SyncResult) add(items []json.RawMessage) error {
+	for _, item := range items {
+		var entity struct {
+			Sys *Sys `json:"sys"`
+		}
+
+		if err := json.Unmarshal(item, &entity); err != nil {
+			return err
+		}
+
+		if entity.Sys == nil {
+			return fmt.Errorf("contentful: sync item without sys")
+		}
+
+		var err error
+		switch entity.Sys.Type {
+		case "Entry":
+			var entry Entry
+			err = json.Unmarshal(item, &entry)
+			result.Entries = append(result.Entries, &entry)
+		case "Asset":
+			var asset Asset
+			err = json.Unmarshal(item, &asset)
+			result.Assets = append(result.Assets, &asset)
+		case "DeletedEntry":
+			result.DeletedEntries = append(result.DeletedEntries, &DeletedEntry{Sys: entity.Sys})
+		case "DeletedAsset":
+			result.DeletedAssets = append(result.DeletedAssets, &DeletedAsset{Sys: entity.Sys})
+		default:
+			err = fmt.Errorf("contentful: unknown sync item type %q", entity.Sys.Type)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// syncToken extracts the sync token from a nextPageUrl or nextSyncUrl
+func syncToken(rawURL string) (string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", err
+	}
+
+	token := u.Query().Get("sync_token")
+	if token == "" {
+		return "", fmt.Errorf("contentful: no sync token in %q", rawURL)
+	}
+
+	return token, nil
+}
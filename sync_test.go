@@ -0,0 +1,97 @@
+package contentful
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncService_Initial(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "GET")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/master/sync")
+		assertions.Equal("Bearer "+CDAToken, r.Header.Get("Authorization"))
+
+		query := r.URL.Query()
+		w.WriteHeader(200)
+
+		switch query.Get("sync_token") {
+		case "":
+			assertions.Equal("true", query.Get("initial"))
+			assertions.Equal("Entry", query.Get("type"))
+			assertions.Equal("cat", query.Get("content_type"))
+			_, _ = fmt.Fprintln(w, readTestData("sync_initial.json"))
+		case "page-2-token":
+			assertions.Empty(query.Get("initial"))
+			_, _ = fmt.Fprintln(w, readTestData("sync_initial_page_2.json"))
+		}
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cda client
+	cda := NewCDA(CDAToken)
+	cda.baseURL = server.URL
+
+	result, err := cda.Sync.Initial(context.Background(), spaceID, &SyncOptions{
+		Type:        SyncTypeEntry,
+		ContentType: "cat",
+	})
+	assertions.Nil(err)
+	assertions.Equal("delta-token", result.Token)
+	assertions.Len(result.Entries, 1)
+	assertions.Equal("5KsDBWseXY6QegucYAoacS", result.Entries[0].Sys.ID)
+	assertions.Equal("Hello, World!", result.Entries[0].Fields["title"].(map[string]interface{})["en-US"])
+	assertions.Len(result.Assets, 1)
+	assertions.Equal("hehehe", result.Assets[0].Fields.Title["en-US"])
+	assertions.Empty(result.DeletedEntries)
+	assertions.Empty(result.DeletedAssets)
+}
+
+func TestSyncService_Delta(t *testing.T) {
+	assertions := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assertions.Equal(r.Method, "GET")
+		assertions.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/staging/sync")
+		assertions.Equal("delta-token", r.URL.Query().Get("sync_token"))
+
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintln(w, readTestData("sync_delta.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cda client
+	cda := NewCDA(CDAToken)
+	cda.baseURL = server.URL
+	cda.SetEnvironment("staging")
+
+	result, err := cda.Sync.Delta(context.Background(), spaceID, "delta-token")
+	assertions.Nil(err)
+	assertions.Equal("next-delta-token", result.Token)
+	assertions.Empty(result.Entries)
+	assertions.Empty(result.Assets)
+	assertions.Len(result.DeletedEntries, 1)
+	assertions.Equal("4aGeQYgByqQFJtToAOh2JJ", result.DeletedEntries[0].Sys.ID)
+	assertions.Equal("2015-05-20T11:29:46.809Z", result.DeletedEntries[0].Sys.DeletedAt)
+	assertions.Len(result.DeletedAssets, 1)
+	assertions.Equal("1x0xpXu4pSGS4OukSyWGUK", result.DeletedAssets[0].Sys.ID)
+}
+
+func TestSyncToken(t *testing.T) {
+	assertions := assert.New(t)
+
+	_, err := syncToken("https://cdn.contentful.com/spaces/id1/environments/master/sync")
+	assertions.NotNil(err)
+}
@@ -20,4 +20,12 @@ type Sys struct {
 	ArchivedAt       string       `json:"archivedAt,omitempty"`
 	ArchivedBy       *Sys         `json:"archivedBy,omitempty"`
 	ArchivedVersion  int          `json:"archivedVersion,omitempty"`
+	DeletedAt        string       `json:"deletedAt,omitempty"`
+	Locale           string       `json:"locale,omitempty"`
+}
+
+// Location model, the value of a Location field
+type Location struct {
+	Lat float64 `json:"lat"`
+	Lon float64 `json:"lon"`
 }
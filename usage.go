@@ -1,6 +1,9 @@
 package contentful
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // UsagesService service
 type UsagesService service
@@ -33,7 +36,7 @@ func (service *UsagesService) GetOrganizationUsage(organizationID, orderBy, metr
 	)
 	method := "GET"
 
-	req, err := service.c.newRequest(method, path, nil, nil)
+	req, err := service.c.newRequest(context.Background(), method, path, nil, nil)
 	if err != nil {
 		return nil
 	}
@@ -57,7 +60,7 @@ func (service *UsagesService) GetSpaceUsage(organizationID, orderBy, metric, sta
 	)
 	method := "GET"
 
-	req, err := service.c.newRequest(method, path, nil, nil)
+	req, err := service.c.newRequest(context.Background(), method, path, nil, nil)
 	if err != nil {
 		return nil
 	}
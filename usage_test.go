@@ -1,6 +1,7 @@
 package contentful
 
 import (
+	"context"
 	"fmt"
 	"github.com/stretchr/testify/assert"
 	"net/http"
@@ -28,9 +29,9 @@ func TestUsagesService_GetOrganizationUsage(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	res, err := cma.Usages.GetOrganizationUsage("organization_id", "-usage", "cma,cpa,gql", "2020-01-01", "2020-01-03").Next()
+	res, err := cma.Usages.GetOrganizationUsage("organization_id", "-usage", "cma,cpa,gql", "2020-01-01", "2020-01-03").Next(context.Background())
 	assertions.Nil(err)
 
 	usage := res.ToUsage()
@@ -59,9 +60,9 @@ func TestUsagesService_GetOrganizationUsage_2(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	_, err = cma.Usages.GetOrganizationUsage("organization_id", "-usage", "cma,cpa,gql", "2020-01-01", "2020-01-03").Next()
+	_, err = cma.Usages.GetOrganizationUsage("organization_id", "-usage", "cma,cpa,gql", "2020-01-01", "2020-01-03").Next(context.Background())
 	assertions.NotNil(err)
 }
 
@@ -85,9 +86,9 @@ func TestUsagesService_GetSpaceUsage(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	res, err := cma.Usages.GetSpaceUsage("organization_id", "-usage", "cma,cpa,gql", "2020-01-01", "2020-01-03").Next()
+	res, err := cma.Usages.GetSpaceUsage("organization_id", "-usage", "cma,cpa,gql", "2020-01-01", "2020-01-03").Next(context.Background())
 	assertions.Nil(err)
 
 	usage := res.ToUsage()
@@ -117,9 +118,9 @@ func TestUsagesService_GetSpaceUsage_2(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	_, err = cma.Usages.GetSpaceUsage("organization_id", "-usage", "cma,cpa,gql", "2020-01-01", "2020-01-03").Next()
+	_, err = cma.Usages.GetSpaceUsage("organization_id", "-usage", "cma,cpa,gql", "2020-01-01", "2020-01-03").Next(context.Background())
 	assertions.NotNil(err)
 
 }
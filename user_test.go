@@ -1,6 +1,7 @@
 package contentful
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -29,9 +30,9 @@ func TestUsersService_Me(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	user, err := cma.Users.Me()
+	user, err := cma.Users.Me(context.Background())
 	assertions.Nil(err)
 	assertions.Equal("j.doe@labdigital.nl", user.Email)
 }
@@ -56,8 +57,8 @@ func TestUsersService_Me_2(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	_, err = cma.Users.Me()
+	_, err = cma.Users.Me(context.Background())
 	assertions.NotEmpty(err)
 }
@@ -1,6 +1,7 @@
 package contentful
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -29,9 +30,9 @@ func TestWebhookCallsService_List(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	collection, err := cma.WebhookCalls.List(spaceID, "0KzM2HxYr5O1pZ4SaUzK8h").Next()
+	collection, err := cma.WebhookCalls.List(spaceID, "0KzM2HxYr5O1pZ4SaUzK8h").Next(context.Background())
 	assertions.Nil(err)
 
 	spaces := collection.ToWebhookCall()
@@ -59,9 +60,9 @@ func TestWebhookCallsService_Get(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	callDetails, err := cma.WebhookCalls.Get(spaceID, "0KzM2HxYr5O1pZ4SaUzK8h", "bar")
+	callDetails, err := cma.WebhookCalls.Get(context.Background(), spaceID, "0KzM2HxYr5O1pZ4SaUzK8h", "bar")
 	assertions.Nil(err)
 	assertions.Equal("bar", callDetails.Sys.ID)
 	assertions.Equal("https://webhooks.example.com/endpoint", callDetails.Request.URL)
@@ -87,10 +88,11 @@ func TestWebhookCallsService_Get_2(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	_, err = cma.WebhookCalls.Get(spaceID, "0KzM2HxYr5O1pZ4SaUzK8h", "bar")
-	assertions.Empty(err)
+	webhookCall, err := cma.WebhookCalls.Get(context.Background(), spaceID, "0KzM2HxYr5O1pZ4SaUzK8h", "bar")
+	assertions.NotNil(err)
+	assertions.Nil(webhookCall)
 }
 
 func TestWebhookCallsService_Health(t *testing.T) {
@@ -113,9 +115,9 @@ func TestWebhookCallsService_Health(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	health, err := cma.WebhookCalls.Health(spaceID, "0KzM2HxYr5O1pZ4SaUzK8h")
+	health, err := cma.WebhookCalls.Health(context.Background(), spaceID, "0KzM2HxYr5O1pZ4SaUzK8h")
 	assertions.Nil(err)
 	assertions.Equal("bar", health.Sys.ID)
 	assertions.Equal(233, health.Calls.Total)
@@ -141,8 +143,9 @@ func TestWebhookCallsService_Health_2(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	_, err = cma.WebhookCalls.Health(spaceID, "0KzM2HxYr5O1pZ4SaUzK8h")
-	assertions.Nil(err)
+	health, err := cma.WebhookCalls.Health(context.Background(), spaceID, "0KzM2HxYr5O1pZ4SaUzK8h")
+	assertions.NotNil(err)
+	assertions.Nil(health)
 }
@@ -1,6 +1,7 @@
 package contentful
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -30,9 +31,9 @@ func TestWebhooksService_List(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	collection, err := cma.Webhooks.List(spaceID).Next()
+	collection, err := cma.Webhooks.List(spaceID).Next(context.Background())
 	assertions.Nil(err)
 	webhook := collection.ToWebhook()
 	assertions.Equal(1, len(webhook))
@@ -59,9 +60,9 @@ func TestWebhooksService_Get(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	webhook, err := cma.Webhooks.Get(spaceID, "7fstd9fZ9T2p3kwD49FxhI")
+	webhook, err := cma.Webhooks.Get(context.Background(), spaceID, "7fstd9fZ9T2p3kwD49FxhI")
 	assertions.Nil(err)
 	assertions.Equal("webhook-name", webhook.Name)
 }
@@ -86,9 +87,9 @@ func TestWebhooksService_Get_2(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
-	_, err = cma.Webhooks.Get(spaceID, "7fstd9fZ9T2p3kwD49FxhI")
+	_, err = cma.Webhooks.Get(context.Background(), spaceID, "7fstd9fZ9T2p3kwD49FxhI")
 	assertions.NotNil(err)
 }
 
@@ -135,7 +136,7 @@ func TestWebhooksService_Upsert_Create(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	webhook := &Webhook{
 		Name: "webhook-name",
@@ -158,7 +159,7 @@ func TestWebhooksService_Upsert_Create(t *testing.T) {
 		},
 	}
 
-	err = cma.Webhooks.Upsert(spaceID, webhook)
+	err = cma.Webhooks.Upsert(context.Background(), spaceID, webhook)
 	assertions.Nil(err)
 	assertions.Equal("7fstd9fZ9T2p3kwD49FxhI", webhook.Sys.ID)
 	assertions.Equal("webhook-name", webhook.Name)
@@ -209,7 +210,7 @@ func TestWebhooksService_Upsert_Update(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	// test webhook
 	webhook, err := webhookFromTestData("webhook_1.json")
@@ -235,7 +236,7 @@ func TestWebhooksService_Upsert_Update(t *testing.T) {
 		},
 	}
 
-	err = cma.Webhooks.Upsert(spaceID, webhook)
+	err = cma.Webhooks.Upsert(context.Background(), spaceID, webhook)
 	assertions.Nil(err)
 	assertions.Equal("7fstd9fZ9T2p3kwD49FxhI", webhook.Sys.ID)
 	assertions.Equal(1, webhook.Sys.Version)
@@ -261,12 +262,12 @@ func TestWebhooksService_Delete(t *testing.T) {
 
 	// cma client
 	cma = NewCMA(CMAToken)
-	cma.BaseURL = server.URL
+	cma.baseURL = server.URL
 
 	// test webhook
 	webhook, err := webhookFromTestData("webhook_1.json")
 	assertions.Nil(err)
 
-	err = cma.Webhooks.Delete(spaceID, webhook)
+	err = cma.Webhooks.Delete(context.Background(), spaceID, webhook)
 	assertions.Nil(err)
 }